@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -22,14 +23,32 @@ type Binance interface {
 	OrderBook(obr OrderBookRequest) (*OrderBook, error)
 	// AggTrades returns compressed/aggregate list of trades.
 	AggTrades(atr AggTradesRequest) ([]*AggTrade, error)
+	// RecentTrades returns list of recent trades.
+	RecentTrades(rtr RecentTradesRequest) ([]*MarketTrade, error)
+	// HistoricalTrades returns list of older trades.
+	HistoricalTrades(htr HistoricalTradesRequest) ([]*MarketTrade, error)
 	// Klines returns klines/candlestick data.
 	Klines(kr KlinesRequest) ([]*Kline, error)
+	// UIKlines returns klines/candlestick data optimized for presentation.
+	UIKlines(kr KlinesRequest) ([]*Kline, error)
 	// Ticker24 returns 24hr price change statistics.
 	Ticker24(tr TickerRequest) (*Ticker24, error)
 	// TickerAllPrices returns ticker data for symbols.
 	TickerAllPrices() ([]*PriceTicker, error)
 	// TickerAllBooks returns tickers for all books.
 	TickerAllBooks() ([]*BookTicker, error)
+	// AvgPrice returns current average price for symbol.
+	AvgPrice(apr AvgPriceRequest) (*AvgPrice, error)
+	// TickerPrice returns latest prices for selected symbols.
+	TickerPrice(tr TickersRequest) ([]*PriceTicker, error)
+	// BookTicker returns best prices and quantities on order book for selected symbols.
+	BookTicker(tr TickersRequest) ([]*BookTicker, error)
+	// Tickers24 returns 24hr price change statistics for selected symbols.
+	Tickers24(tr TickersRequest) ([]*Ticker24, error)
+	// RollingTickers returns price change statistics within custom window.
+	RollingTickers(rtr RollingTickersRequest) ([]*Ticker24, error)
+	// TradingDayTickers returns price change statistics for current trading day.
+	TradingDayTickers(tr TradingDayTickersRequest) ([]*Ticker24, error)
 
 	// NewOrder places new order and returns ProcessedOrder.
 	NewOrder(nor NewOrderRequest) (*ProcessedOrder, error)
@@ -48,12 +67,83 @@ type Binance interface {
 	Account(ar AccountRequest) (*Account, error)
 	// MyTrades list user's trades.
 	MyTrades(mtr MyTradesRequest) ([]*Trade, error)
+	// AccountSnapshot returns daily snapshots of spot, margin or futures account.
+	AccountSnapshot(asr AccountSnapshotRequest) ([]*AccountSnapshot, error)
+	// APIKeyPermission returns permissions of API key.
+	APIKeyPermission(ar AccountRequest) (*APIKeyPermission, error)
+	// AccountStatus returns account status.
+	AccountStatus(ar AccountRequest) (*AccountStatus, error)
+	// APITradingStatus returns API trading status with quantitative rules indicators.
+	APITradingStatus(ar AccountRequest) (*APITradingStatus, error)
+	// TradeFee returns maker and taker commission of symbol, or of all symbols
+	// when symbol is empty.
+	TradeFee(tfr TradeFeeRequest) ([]*TradeFee, error)
+	// Commission returns commission rates and discount of symbol.
+	Commission(cr CommissionRequest) (*AccountCommission, error)
 	// Withdraw executes withdrawal.
 	Withdraw(wr WithdrawRequest) (*WithdrawResult, error)
 	// DepositHistory lists deposit data.
-	DepositHistory(hr HistoryRequest) ([]*Deposit, error)
+	DepositHistory(hr DepositHistoryRequest) ([]*Deposit, error)
 	// WithdrawHistory lists withdraw data.
-	WithdrawHistory(hr HistoryRequest) ([]*Withdrawal, error)
+	WithdrawHistory(hr WithdrawHistoryRequest) ([]*Withdrawal, error)
+	// CoinConfig returns deposit and withdraw rules of all assets and their networks.
+	CoinConfig(ccr CoinConfigRequest) ([]*CoinConfig, error)
+	// DepositAddress returns deposit address of asset on network.
+	DepositAddress(dar DepositAddressRequest) (*DepositAddress, error)
+	// SubAccounts lists sub-accounts of master account.
+	SubAccounts(sar SubAccountsRequest) ([]*SubAccount, error)
+	// CreateVirtualSubAccount creates virtual sub-account and returns its email.
+	CreateVirtualSubAccount(cvr CreateVirtualSubAccountRequest) (string, error)
+	// SubAccountAssets returns spot balances of sub-account.
+	SubAccountAssets(sar SubAccountRequest) ([]*Balance, error)
+	// SubAccountMarginAccount returns margin account of sub-account.
+	SubAccountMarginAccount(sar SubAccountRequest) (*SubAccountMarginAccount, error)
+	// SubAccountTransfer transfers asset between master account and
+	// sub-accounts.
+	SubAccountTransfer(str SubAccountTransferRequest) (*SubAccountTransferResult, error)
+	// SubAccountTransferHistory lists transfers between master account and
+	// sub-accounts.
+	SubAccountTransferHistory(sthr SubAccountTransferHistoryRequest) (*SubAccountTransferHistory, error)
+	// DustLog lists conversions of small balances to BNB.
+	DustLog(dlr DustLogRequest) (*DustLog, error)
+	// DustAssets lists assets that can be converted to BNB.
+	DustAssets(ar AccountRequest) (*DustAssets, error)
+	// DustTransfer converts small balances of assets to BNB.
+	DustTransfer(dtr DustTransferRequest) (*DustTransferResult, error)
+	// AssetDividendRecord lists asset dividends and distributions.
+	AssetDividendRecord(adr AssetDividendRequest) (*AssetDividendHistory, error)
+	// AssetDetail returns deposit and withdraw details of assets by asset name.
+	AssetDetail(adr AssetDetailRequest) (map[string]*AssetDetail, error)
+	// FlexibleEarnProducts lists Simple Earn flexible products.
+	FlexibleEarnProducts(epr EarnProductsRequest) (*FlexibleEarnProductList, error)
+	// LockedEarnProducts lists Simple Earn locked products.
+	LockedEarnProducts(epr EarnProductsRequest) (*LockedEarnProductList, error)
+	// SubscribeFlexibleEarn subscribes to flexible product.
+	SubscribeFlexibleEarn(sfr SubscribeFlexibleEarnRequest) (*EarnSubscription, error)
+	// SubscribeLockedEarn subscribes to locked product.
+	SubscribeLockedEarn(slr SubscribeLockedEarnRequest) (*EarnSubscription, error)
+	// RedeemFlexibleEarn redeems flexible product.
+	RedeemFlexibleEarn(rfr RedeemFlexibleEarnRequest) (*EarnRedemption, error)
+	// RedeemLockedEarn redeems locked product position early.
+	RedeemLockedEarn(rlr RedeemLockedEarnRequest) (*EarnRedemption, error)
+	// FlexibleEarnPositions lists positions in flexible products.
+	FlexibleEarnPositions(fpr FlexibleEarnPositionsRequest) (*FlexibleEarnPositionList, error)
+	// LockedEarnPositions lists positions in locked products.
+	LockedEarnPositions(lpr LockedEarnPositionsRequest) (*LockedEarnPositionList, error)
+	// FlexibleEarnRewards lists rewards of flexible products.
+	FlexibleEarnRewards(frr FlexibleEarnRewardsRequest) (*FlexibleEarnRewardHistory, error)
+	// LockedEarnRewards lists rewards of locked products.
+	LockedEarnRewards(lrr LockedEarnRewardsRequest) (*LockedEarnRewardHistory, error)
+	// ConvertExchangeInfo lists Convert pairs with their amount limits.
+	ConvertExchangeInfo(cpr ConvertPairsRequest) ([]*ConvertPair, error)
+	// ConvertGetQuote requests Convert quote.
+	ConvertGetQuote(cqr ConvertQuoteRequest) (*ConvertQuote, error)
+	// ConvertAcceptQuote accepts Convert quote before it expires.
+	ConvertAcceptQuote(car ConvertAcceptRequest) (*ConvertOrder, error)
+	// ConvertOrderStatus returns Convert order by order or quote ID.
+	ConvertOrderStatus(cosr ConvertOrderStatusRequest) (*ConvertOrder, error)
+	// ConvertTradeHistory lists Convert orders.
+	ConvertTradeHistory(cthr ConvertTradeHistoryRequest) (*ConvertTradeHistory, error)
 
 	// StartUserDataStream starts stream and returns Stream with ListenKey.
 	StartUserDataStream() (*Stream, error)
@@ -72,12 +162,60 @@ type Binance interface {
 	QueryMarginOrder(qor QueryOrderRequest) (*ExecutedOrder, error)
 	CancelMarginOrder(cor CancelOrderRequest) (*CanceledOrder, error)
 	OpenMarginOrders(oor OpenOrdersRequest) ([]*ExecutedOrder, error)
+	// CancelOpenMarginOrders cancels all open margin orders of symbol.
+	CancelOpenMarginOrders(oor OpenOrdersRequest) ([]*CanceledOrder, error)
+	// NewMarginOCO places margin OCO order list.
+	NewMarginOCO(nor NewMarginOCORequest) (*OrderList, error)
+	// QueryMarginOCO returns margin OCO order list.
+	QueryMarginOCO(olr OrderListRequest) (*OrderList, error)
+	// CancelMarginOCO cancels margin OCO order list.
+	CancelMarginOCO(olr OrderListRequest) (*OrderList, error)
+	// MarginOrderCount returns usage of margin order rate limits.
+	MarginOrderCount(mocr MarginOrderCountRequest) ([]*OrderCount, error)
 	AllMarginOrders(aor AllOrdersRequest) ([]*ExecutedOrder, error)
+	// MarginAccount returns cross margin account data.
 	MarginAccount(ar AccountRequest) (*MarginAccount, error)
+	// IsolatedMarginAccount returns isolated margin account data.
+	IsolatedMarginAccount(imar IsolatedMarginAccountRequest) (*IsolatedMarginAccount, error)
+	// EnableIsolatedMarginAccount enables isolated margin account of symbol.
+	EnableIsolatedMarginAccount(isr IsolatedSymbolRequest) error
+	// DisableIsolatedMarginAccount disables isolated margin account of symbol.
+	DisableIsolatedMarginAccount(isr IsolatedSymbolRequest) error
+	// AllIsolatedMarginSymbols lists isolated margin pairs, or the one given by Symbol.
+	AllIsolatedMarginSymbols(isr IsolatedSymbolRequest) ([]*IsolatedMarginSymbol, error)
+	// MarginInterestHistory lists interest charged on margin loans.
+	MarginInterestHistory(ihr InterestHistoryRequest) (*InterestHistory, error)
+	// ForceLiquidations lists force liquidation orders.
+	ForceLiquidations(flr ForceLiquidationRequest) (*ForceLiquidationHistory, error)
+	// MarginInterestRateHistory lists daily interest rates of asset.
+	MarginInterestRateHistory(irhr InterestRateHistoryRequest) ([]*InterestRate, error)
+	// CrossMarginCollateralRatio returns collateral discount rates of cross margin assets.
+	CrossMarginCollateralRatio() ([]*CollateralRatio, error)
+	// MarginPriceIndex returns margin price index of symbol.
+	MarginPriceIndex(mpir MarginPriceIndexRequest) (*MarginPriceIndex, error)
 	MyMarginTrades(mtr MyTradesRequest) ([]*Trade, error)
 	AllMarginAssets(ar AccountRequest) ([]*MarginAsset, error)
-	MaxBorrow(mbr MaxMarginRequest) (float64, error)
+	MaxBorrow(mbr MaxMarginRequest) (*MaxBorrowable, error)
 	MaxTransfer(mbr MaxMarginRequest) (float64, error)
+	// MarginBorrow borrows asset to cross or isolated margin account.
+	MarginBorrow(mlr MarginLoanRequest) (*MarginLoanResult, error)
+	// MarginRepay repays borrowed asset of cross or isolated margin account.
+	MarginRepay(mlr MarginLoanRequest) (*MarginLoanResult, error)
+	// MarginLoanRecords lists borrow records.
+	MarginLoanRecords(mlrr MarginLoanRecordsRequest) (*MarginLoanHistory, error)
+	// MarginRepayRecords lists repay records.
+	MarginRepayRecords(mlrr MarginLoanRecordsRequest) (*MarginLoanHistory, error)
+
+	// UniversalTransfer transfers asset between wallets.
+	UniversalTransfer(utr UniversalTransferRequest) (*TransferResult, error)
+	// UniversalTransferHistory lists universal transfers of given type.
+	UniversalTransferHistory(uthr UniversalTransferHistoryRequest) (*TransferHistory, error)
+	// MarginTransfer transfers asset between spot and cross margin wallet.
+	MarginTransfer(mtr MarginTransferRequest) (*TransferResult, error)
+	// IsolatedMarginTransfer transfers asset between spot and isolated margin wallet.
+	IsolatedMarginTransfer(imtr IsolatedMarginTransferRequest) (*TransferResult, error)
+	// MarginTransferHistory lists cross or isolated margin transfers.
+	MarginTransferHistory(mthr MarginTransferHistoryRequest) (*MarginTransferHistory, error)
 }
 
 type binance struct {
@@ -88,6 +226,8 @@ type binance struct {
 type Error struct {
 	Code    int    `json:"code"`
 	Message string `json:"msg"`
+	// StatusCode is HTTP status of response.
+	StatusCode int `json:"-"`
 }
 
 // Error returns formatted error message.
@@ -95,6 +235,21 @@ func (e Error) Error() string {
 	return fmt.Sprintf("%d: %s", e.Code, e.Message)
 }
 
+// maxErrorBody limits body kept in HTTPError.
+const maxErrorBody = 512
+
+// HTTPError is returned when error response doesn't carry Binance error,
+// e.g. HTML page returned by gateway.
+type HTTPError struct {
+	StatusCode int
+	Body       string
+}
+
+// Error returns formatted error message.
+func (e HTTPError) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Body)
+}
+
 // NewBinance returns Binance instance.
 func NewBinance(service Service) Binance {
 	return &binance{
@@ -173,13 +328,53 @@ func (b *binance) AggTrades(atr AggTradesRequest) ([]*AggTrade, error) {
 	return b.Service.AggTrades(atr)
 }
 
+// MarketTrade represents single trade executed on market.
+type MarketTrade struct {
+	ID           int64
+	Price        float64
+	Qty          float64
+	QuoteQty     float64
+	Time         time.Time
+	IsBuyerMaker bool
+	IsBestMatch  bool
+}
+
+// RecentTradesRequest represents RecentTrades request data.
+type RecentTradesRequest struct {
+	Symbol string
+	Limit  int
+}
+
+// RecentTrades returns list of recent trades.
+func (b *binance) RecentTrades(rtr RecentTradesRequest) ([]*MarketTrade, error) {
+	return b.Service.RecentTrades(rtr)
+}
+
+// HistoricalTradesRequest represents HistoricalTrades request data.
+//
+// Most recent trades are returned when FromID is nil.
+type HistoricalTradesRequest struct {
+	Symbol string
+	Limit  int
+	FromID *int64
+}
+
+// HistoricalTrades returns list of older trades.
+func (b *binance) HistoricalTrades(htr HistoricalTradesRequest) ([]*MarketTrade, error) {
+	return b.Service.HistoricalTrades(htr)
+}
+
 // KlinesRequest represents Klines request data.
+//
+// TimeZone is UTC offset (e.g. "-1:00", "05:45") used to interpret intervals,
+// UTC is used when empty.
 type KlinesRequest struct {
 	Symbol    string
 	Interval  Interval
 	Limit     int
 	StartTime int64
 	EndTime   int64
+	TimeZone  string
 }
 
 // Kline represents single Kline information.
@@ -211,24 +406,37 @@ func (b *binance) Klines(kr KlinesRequest) ([]*Kline, error) {
 	return b.Service.Klines(kr)
 }
 
+// UIKlines returns klines/candlestick data optimized for presentation.
+func (b *binance) UIKlines(kr KlinesRequest) ([]*Kline, error) {
+	return b.Service.UIKlines(kr)
+}
+
 // TickerRequest represents Ticker request data.
 type TickerRequest struct {
 	Symbol string
 }
 
 // Ticker24 represents data for 24hr ticker.
+//
+// Tickers of TickerTypeMini type and rolling window tickers don't contain
+// some of the fields, these are left zero.
 type Ticker24 struct {
+	Symbol             string
 	PriceChange        float64
 	PriceChangePercent float64
 	WeightedAvgPrice   float64
 	PrevClosePrice     float64
 	LastPrice          float64
+	LastQty            float64
 	BidPrice           float64
+	BidQty             float64
 	AskPrice           float64
+	AskQty             float64
 	OpenPrice          float64
 	HighPrice          float64
 	LowPrice           float64
 	Volume             float64
+	QuoteVolume        float64
 	OpenTime           time.Time
 	CloseTime          time.Time
 	FirstID            int
@@ -266,6 +474,77 @@ func (b *binance) TickerAllBooks() ([]*BookTicker, error) {
 	return b.Service.TickerAllBooks()
 }
 
+// AvgPriceRequest represents AvgPrice request data.
+type AvgPriceRequest struct {
+	Symbol string
+}
+
+// AvgPrice represents average price of symbol.
+type AvgPrice struct {
+	Mins      int
+	Price     float64
+	CloseTime time.Time
+}
+
+// AvgPrice returns current average price for symbol.
+func (b *binance) AvgPrice(apr AvgPriceRequest) (*AvgPrice, error) {
+	return b.Service.AvgPrice(apr)
+}
+
+// TickersRequest represents request data for tickers of selected symbols.
+//
+// Tickers for all symbols are returned when Symbols is empty. Type is used
+// by Tickers24 only.
+type TickersRequest struct {
+	Symbols []string
+	Type    TickerType
+}
+
+// TickerPrice returns latest prices for selected symbols.
+func (b *binance) TickerPrice(tr TickersRequest) ([]*PriceTicker, error) {
+	return b.Service.TickerPrice(tr)
+}
+
+// BookTicker returns best prices and quantities on order book for selected symbols.
+func (b *binance) BookTicker(tr TickersRequest) ([]*BookTicker, error) {
+	return b.Service.BookTicker(tr)
+}
+
+// Tickers24 returns 24hr price change statistics for selected symbols.
+func (b *binance) Tickers24(tr TickersRequest) ([]*Ticker24, error) {
+	return b.Service.Tickers24(tr)
+}
+
+// RollingTickersRequest represents RollingTickers request data.
+//
+// At least one symbol is required. WindowSize must be 1-59m, 1-23h or 1-7d,
+// 1d is used by API when not set.
+type RollingTickersRequest struct {
+	Symbols    []string
+	WindowSize time.Duration
+	Type       TickerType
+}
+
+// RollingTickers returns price change statistics within custom window.
+func (b *binance) RollingTickers(rtr RollingTickersRequest) ([]*Ticker24, error) {
+	return b.Service.RollingTickers(rtr)
+}
+
+// TradingDayTickersRequest represents TradingDayTickers request data.
+//
+// At least one symbol is required. TimeZone is UTC offset (e.g. "-1:00",
+// "05:45") where trading day starts, UTC is used when empty.
+type TradingDayTickersRequest struct {
+	Symbols  []string
+	TimeZone string
+	Type     TickerType
+}
+
+// TradingDayTickers returns price change statistics for current trading day.
+func (b *binance) TradingDayTickers(tr TradingDayTickersRequest) ([]*Ticker24, error) {
+	return b.Service.TradingDayTickers(tr)
+}
+
 // NewOrderRequest represents NewOrder request data.
 type NewOrderRequest struct {
 	Symbol           string
@@ -282,20 +561,102 @@ type NewOrderRequest struct {
 }
 
 // NewMarginOrderRequest represents NewMarginOrder request data.
+//
+// AutoRepayAtCancel applies to orders with MARGIN_BUY or AUTO_BORROW_REPAY
+// side effect, exchange repays the borrowed amount on cancel when it is nil.
 type NewMarginOrderRequest struct {
-	Symbol           string
-	Side             OrderSide
-	Type             OrderType
-	Quantity         float64
-	Price            float64
-	StopPrice        float64
-	NewClientOrderID string
-	IcebergQty       float64
-	NewOrderRespType NewOrderRespType
-	IsIsolated       bool
-	SideEffectType   MarginOrderSideEffect
-	TimeInForce      TimeInForce
-	Timestamp        time.Time
+	Symbol                  string
+	Side                    OrderSide
+	Type                    OrderType
+	Quantity                float64
+	Price                   float64
+	StopPrice               float64
+	NewClientOrderID        string
+	IcebergQty              float64
+	NewOrderRespType        NewOrderRespType
+	IsIsolated              bool
+	SideEffectType          MarginOrderSideEffect
+	TimeInForce             TimeInForce
+	SelfTradePreventionMode SelfTradePreventionMode
+	AutoRepayAtCancel       *bool
+	RecvWindow              time.Duration
+	Timestamp               time.Time
+}
+
+// NewMarginOCORequest represents NewMarginOCO request data. The OCO pairs
+// limit order at Price with stop-limit order at StopPrice.
+type NewMarginOCORequest struct {
+	Symbol                  string
+	IsIsolated              bool
+	ListClientOrderID       string
+	Side                    OrderSide
+	Quantity                float64
+	LimitClientOrderID      string
+	Price                   float64
+	LimitIcebergQty         float64
+	StopClientOrderID       string
+	StopPrice               float64
+	StopLimitPrice          float64
+	StopIcebergQty          float64
+	StopLimitTimeInForce    TimeInForce
+	NewOrderRespType        NewOrderRespType
+	SideEffectType          MarginOrderSideEffect
+	SelfTradePreventionMode SelfTradePreventionMode
+	AutoRepayAtCancel       *bool
+	RecvWindow              time.Duration
+	Timestamp               time.Time
+}
+
+// OrderListRequest represents QueryMarginOCO and CancelMarginOCO request
+// data. Either OrderListID or ListClientOrderID must be set.
+type OrderListRequest struct {
+	Symbol            string
+	IsIsolated        bool
+	OrderListID       int64
+	ListClientOrderID string
+	NewClientOrderID  string
+	RecvWindow        time.Duration
+	Timestamp         time.Time
+}
+
+// OrderList represents order list, such as OCO.
+//
+// OrderReports are returned by NewMarginOCO and CancelMarginOCO only.
+type OrderList struct {
+	OrderListID       int64
+	ContingencyType   string
+	ListStatusType    string
+	ListOrderStatus   string
+	ListClientOrderID string
+	TransactionTime   time.Time
+	Symbol            string
+	IsIsolated        bool
+	Orders            []*OrderListOrder
+	OrderReports      []*ProcessedOrder
+}
+
+// OrderListOrder identifies order of order list.
+type OrderListOrder struct {
+	Symbol        string
+	OrderID       int64
+	ClientOrderID string
+}
+
+// MarginOrderCountRequest represents MarginOrderCount request data.
+type MarginOrderCountRequest struct {
+	IsIsolated bool
+	Symbol     string
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// OrderCount represents usage of order rate limit.
+type OrderCount struct {
+	RateLimitType string
+	Interval      string
+	IntervalNum   int
+	Limit         int
+	Count         int
 }
 
 // ProcessedOrder represents data from processed order.
@@ -399,6 +760,8 @@ func (b *binance) OpenOrders(oor OpenOrdersRequest) ([]*ExecutedOrder, error) {
 type AllOrdersRequest struct {
 	Symbol     string
 	OrderID    int64
+	StartTime  time.Time
+	EndTime    time.Time
 	Limit      int
 	IsIsolated bool
 	RecvWindow time.Duration
@@ -418,17 +781,29 @@ type AccountRequest struct {
 }
 
 // Account represents user's account information.
+//
+// Commision fields are in basis points, CommissionRates holds the same rates
+// as fractions. Per-symbol rates and discounts are returned by Commission.
 type Account struct {
 	MakerCommision  int64
 	TakerCommision  int64
 	BuyerCommision  int64
 	SellerCommision int64
+	CommissionRates *CommissionRates
 	CanTrade        bool
 	CanWithdraw     bool
 	CanDeposit      bool
 	Balances        []*Balance
 }
 
+// CommissionRates represents commission rates as fractions of traded amount.
+type CommissionRates struct {
+	Maker  float64
+	Taker  float64
+	Buyer  float64
+	Seller float64
+}
+
 type MarginAccount struct {
 	BorrowEnabled       bool
 	MarginLevel         float64
@@ -457,14 +832,250 @@ type MarginAsset struct {
 	UserMinRepay  float64
 }
 
+// MaxMarginRequest represents MaxBorrow and MaxTransfer request data.
+// IsolatedSymbol selects isolated margin pair, cross margin is used when empty.
 type MaxMarginRequest struct {
+	Asset          string
+	IsolatedSymbol string
+	RecvWindow     time.Duration
+	Timestamp      time.Time
+}
+
+// IsolatedMarginAccountRequest represents IsolatedMarginAccount request data.
+// All pairs are returned when Symbols is empty, up to 5 symbols can be given.
+type IsolatedMarginAccountRequest struct {
+	Symbols    []string
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// IsolatedMarginAccount represents isolated margin account with its pairs.
+type IsolatedMarginAccount struct {
+	TotalAssetOfBtc     float64
+	TotalLiabilityOfBtc float64
+	TotalNetAssetOfBtc  float64
+	Pairs               []*IsolatedMarginPair
+}
+
+// IsolatedMarginPair represents positions of isolated margin pair.
+//
+// MarginLevelStatus is one of EXCESSIVE, NORMAL, MARGIN_CALL, PRE_LIQUIDATION
+// and FORCE_LIQUIDATION.
+type IsolatedMarginPair struct {
+	Symbol            string
+	Base              *IsolatedMarginAsset
+	Quote             *IsolatedMarginAsset
+	IsolatedCreated   bool
+	Enabled           bool
+	TradeEnabled      bool
+	MarginLevel       float64
+	MarginLevelStatus string
+	MarginRatio       float64
+	IndexPrice        float64
+	LiquidatePrice    float64
+	LiquidateRate     float64
+}
+
+// IsolatedMarginAsset represents asset position of isolated margin pair.
+type IsolatedMarginAsset struct {
+	Asset         string
+	BorrowEnabled bool
+	RepayEnabled  bool
+	Borrowed      float64
+	Free          float64
+	Interest      float64
+	Locked        float64
+	NetAsset      float64
+	NetAssetOfBtc float64
+	TotalAsset    float64
+}
+
+// IsolatedSymbolRequest represents request data of isolated margin pair
+// operations.
+type IsolatedSymbolRequest struct {
 	Symbol     string
-	PairId     string
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// IsolatedMarginSymbol represents isolated margin pair.
+type IsolatedMarginSymbol struct {
+	Symbol        string
+	Base          string
+	Quote         string
+	IsMarginTrade bool
+	IsBuyAllowed  bool
+	IsSellAllowed bool
+}
+
+// MaxBorrowable represents amount of asset that can be borrowed. BorrowLimit
+// is the limit of the account's VIP level.
+type MaxBorrowable struct {
+	Amount      float64
+	BorrowLimit float64
+}
+
+// MarginLoanRequest represents MarginBorrow and MarginRepay request data.
+// Symbol is required for isolated margin.
+type MarginLoanRequest struct {
+	Asset      string
+	Amount     float64
 	IsIsolated bool
+	Symbol     string
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// MarginLoanResult represents result of borrow or repay.
+type MarginLoanResult struct {
+	TranID int64
+}
+
+// MarginLoanRecordsRequest represents MarginLoanRecords and MarginRepayRecords
+// request data. Current is page number starting with 1, Size is page size.
+type MarginLoanRecordsRequest struct {
+	Asset          string
+	IsolatedSymbol string
+	TxID           int64
+	StartTime      time.Time
+	EndTime        time.Time
+	Current        int
+	Size           int
+	RecvWindow     time.Duration
+	Timestamp      time.Time
+}
+
+// MarginLoanHistory represents page of borrow or repay records.
+type MarginLoanHistory struct {
+	Total   int
+	Records []*MarginLoanRecord
+}
+
+// MarginLoanRecord represents borrow or repay record. Type is AUTO for
+// borrows and repays made by order side effects, MANUAL otherwise.
+type MarginLoanRecord struct {
+	TxID           int64
+	Asset          string
+	IsolatedSymbol string
+	Amount         float64
+	Principal      float64
+	Interest       float64
+	Status         TransferStatus
+	Type           string
+	Time           time.Time
+}
+
+// InterestHistoryRequest represents MarginInterestHistory request data.
+// Current is page number starting with 1, Size is page size.
+type InterestHistoryRequest struct {
+	Asset          string
+	IsolatedSymbol string
+	StartTime      time.Time
+	EndTime        time.Time
+	Current        int
+	Size           int
+	RecvWindow     time.Duration
+	Timestamp      time.Time
+}
+
+// InterestHistory represents page of interest records.
+type InterestHistory struct {
+	Total   int
+	Records []*InterestRecord
+}
+
+// InterestRecord represents interest charged on margin loan.
+//
+// Type is one of PERIODIC, ON_BORROW, PERIODIC_CONVERTED and
+// ON_BORROW_CONVERTED. RawAsset differs from Asset when interest was
+// converted to BNB.
+type InterestRecord struct {
+	TxID           int64
+	Asset          string
+	RawAsset       string
+	IsolatedSymbol string
+	Principal      float64
+	Interest       float64
+	InterestRate   float64
+	Type           string
+	Time           time.Time
+}
+
+// ForceLiquidationRequest represents ForceLiquidations request data.
+type ForceLiquidationRequest struct {
+	IsolatedSymbol string
+	StartTime      time.Time
+	EndTime        time.Time
+	Current        int
+	Size           int
+	RecvWindow     time.Duration
+	Timestamp      time.Time
+}
+
+// ForceLiquidationHistory represents page of force liquidation orders.
+type ForceLiquidationHistory struct {
+	Total   int
+	Records []*ForceLiquidation
+}
+
+// ForceLiquidation represents force liquidation order.
+type ForceLiquidation struct {
+	OrderID     int64
+	Symbol      string
+	Side        OrderSide
+	Price       float64
+	AvgPrice    float64
+	Qty         float64
+	ExecutedQty float64
+	TimeInForce TimeInForce
+	IsIsolated  bool
+	UpdateTime  time.Time
+}
+
+// InterestRateHistoryRequest represents MarginInterestRateHistory request
+// data. Rates of user's VIP level are returned when VIPLevel is nil.
+type InterestRateHistoryRequest struct {
+	Asset      string
+	VIPLevel   *int
+	StartTime  time.Time
+	EndTime    time.Time
 	RecvWindow time.Duration
 	Timestamp  time.Time
 }
 
+// InterestRate represents daily interest rate of asset.
+type InterestRate struct {
+	Asset             string
+	DailyInterestRate float64
+	VIPLevel          int
+	Time              time.Time
+}
+
+// CollateralRatio represents collateral discount rates shared by assets.
+type CollateralRatio struct {
+	Assets      []string
+	Collaterals []*CollateralTier
+}
+
+// CollateralTier represents discount rate of collateral value range in USD.
+type CollateralTier struct {
+	MinUSDValue  float64
+	MaxUSDValue  float64
+	DiscountRate float64
+}
+
+// MarginPriceIndexRequest represents MarginPriceIndex request data.
+type MarginPriceIndexRequest struct {
+	Symbol string
+}
+
+// MarginPriceIndex represents margin price index of symbol.
+type MarginPriceIndex struct {
+	Symbol   string
+	Price    float64
+	CalcTime time.Time
+}
+
 type AccountEvent struct {
 	WSEvent
 	Account
@@ -482,11 +1093,189 @@ func (b *binance) Account(ar AccountRequest) (*Account, error) {
 	return b.Service.Account(ar)
 }
 
+// AccountSnapshotRequest represents AccountSnapshot request data. Limit is
+// number of days, between 7 and 30.
+type AccountSnapshotRequest struct {
+	Type       SnapshotType
+	StartTime  time.Time
+	EndTime    time.Time
+	Limit      int
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// AccountSnapshot represents daily account snapshot. Only the field matching
+// Type is set.
+type AccountSnapshot struct {
+	Type       SnapshotType
+	UpdateTime time.Time
+	Spot       *SpotSnapshot
+	Margin     *MarginSnapshot
+	Futures    *FuturesSnapshot
+}
+
+// SpotSnapshot represents snapshot of spot account.
+type SpotSnapshot struct {
+	TotalAssetOfBtc float64
+	Balances        []*Balance
+}
+
+// MarginSnapshot represents snapshot of cross margin account.
+type MarginSnapshot struct {
+	MarginLevel         float64
+	TotalAssetOfBtc     float64
+	TotalLiabilityOfBtc float64
+	TotalNetAssetOfBtc  float64
+	Assets              []*Asset
+}
+
+// FuturesSnapshot represents snapshot of USDⓈ-M futures account.
+type FuturesSnapshot struct {
+	Assets    []*FuturesBalance
+	Positions []*FuturesPosition
+}
+
+// FuturesBalance represents asset balance of futures account.
+type FuturesBalance struct {
+	Asset         string
+	MarginBalance float64
+	WalletBalance float64
+}
+
+// FuturesPosition represents position of futures account.
+type FuturesPosition struct {
+	Symbol           string
+	EntryPrice       float64
+	MarkPrice        float64
+	PositionAmt      float64
+	UnrealizedProfit float64
+}
+
+// AccountSnapshot returns daily snapshots of spot, margin or futures account.
+func (b *binance) AccountSnapshot(asr AccountSnapshotRequest) ([]*AccountSnapshot, error) {
+	return b.Service.AccountSnapshot(asr)
+}
+
+// APIKeyPermission represents permissions of API key.
+//
+// TradingAuthorityExpirationTime is zero when spot and margin trading
+// permission doesn't expire.
+type APIKeyPermission struct {
+	IPRestrict                     bool
+	CreateTime                     time.Time
+	EnableReading                  bool
+	EnableSpotAndMarginTrading     bool
+	EnableWithdrawals              bool
+	EnableInternalTransfer         bool
+	EnableMargin                   bool
+	EnableFutures                  bool
+	EnableVanillaOptions           bool
+	EnablePortfolioMarginTrading   bool
+	PermitsUniversalTransfer       bool
+	TradingAuthorityExpirationTime time.Time
+}
+
+// APIKeyPermission returns permissions of API key.
+func (b *binance) APIKeyPermission(ar AccountRequest) (*APIKeyPermission, error) {
+	return b.Service.APIKeyPermission(ar)
+}
+
+// AccountStatus represents account status, "Normal" for accounts without
+// restrictions.
+type AccountStatus struct {
+	Status string
+}
+
+// AccountStatus returns account status.
+func (b *binance) AccountStatus(ar AccountRequest) (*AccountStatus, error) {
+	return b.Service.AccountStatus(ar)
+}
+
+// APITradingStatus represents API trading status.
+//
+// TriggerCondition holds thresholds of quantitative rules (GCR, IFER, UFR),
+// Indicators holds values of rules per symbol, "ACCOUNT" key is used for
+// account-wide indicators.
+type APITradingStatus struct {
+	IsLocked           bool
+	PlannedRecoverTime time.Time
+	TriggerCondition   map[string]float64
+	Indicators         map[string][]*TradingIndicator
+	UpdateTime         time.Time
+}
+
+// TradingIndicator represents value of quantitative rule indicator.
+type TradingIndicator struct {
+	Name         string
+	Count        int
+	Value        float64
+	TriggerValue float64
+}
+
+// APITradingStatus returns API trading status with quantitative rules indicators.
+func (b *binance) APITradingStatus(ar AccountRequest) (*APITradingStatus, error) {
+	return b.Service.APITradingStatus(ar)
+}
+
+// TradeFeeRequest represents TradeFee request data.
+type TradeFeeRequest struct {
+	Symbol     string
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// TradeFee represents commission rates of symbol as fractions of traded amount.
+type TradeFee struct {
+	Symbol          string
+	MakerCommission float64
+	TakerCommission float64
+}
+
+// TradeFee returns maker and taker commission of symbol, or of all symbols
+// when symbol is empty.
+func (b *binance) TradeFee(tfr TradeFeeRequest) ([]*TradeFee, error) {
+	return b.Service.TradeFee(tfr)
+}
+
+// CommissionRequest represents Commission request data.
+type CommissionRequest struct {
+	Symbol     string
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// AccountCommission represents commission rates of symbol. Standard, special
+// and tax rates add up to commission charged, Discount is nil when exchange
+// doesn't return it.
+type AccountCommission struct {
+	Symbol   string
+	Standard CommissionRates
+	Special  CommissionRates
+	Tax      CommissionRates
+	Discount *CommissionDiscount
+}
+
+// CommissionDiscount represents discount of standard commission paid in
+// DiscountAsset, Discount is multiplier of the standard rate.
+type CommissionDiscount struct {
+	EnabledForAccount bool
+	EnabledForSymbol  bool
+	DiscountAsset     string
+	Discount          float64
+}
+
+// Commission returns commission rates and discount of symbol.
+func (b *binance) Commission(cr CommissionRequest) (*AccountCommission, error) {
+	return b.Service.Commission(cr)
+}
+
 // MyTradesRequest represents MyTrades request data.
 type MyTradesRequest struct {
 	Symbol     string
 	Limit      int
 	FromID     int64
+	StartTime  time.Time
+	EndTime    time.Time
 	IsIsolated bool
 	RecvWindow time.Duration
 	Timestamp  time.Time
@@ -512,19 +1301,26 @@ func (b *binance) MyTrades(mtr MyTradesRequest) ([]*Trade, error) {
 }
 
 // WithdrawRequest represents Withdraw request data.
+//
+// Default network of the asset is used when Network is empty. When
+// TransactionFeeFlag is set, fee is charged to the destination and Amount is
+// the amount deducted from the account.
 type WithdrawRequest struct {
-	Asset      string
-	Address    string
-	Amount     float64
-	Name       string
-	RecvWindow time.Duration
-	Timestamp  time.Time
+	Asset              string
+	Network            string
+	Address            string
+	AddressTag         string
+	Amount             float64
+	WithdrawOrderID    string
+	TransactionFeeFlag bool
+	Name               string
+	RecvWindow         time.Duration
+	Timestamp          time.Time
 }
 
 // WithdrawResult represents Withdraw result.
 type WithdrawResult struct {
-	Success bool
-	Msg     string
+	ID string
 }
 
 // Withdraw executes withdrawal.
@@ -532,68 +1328,1006 @@ func (b *binance) Withdraw(wr WithdrawRequest) (*WithdrawResult, error) {
 	return b.Service.Withdraw(wr)
 }
 
-// HistoryRequest represents history-related calls request data.
-type HistoryRequest struct {
+// DepositHistoryRequest represents DepositHistory request data.
+type DepositHistoryRequest struct {
 	Asset      string
-	Status     *int
+	Status     *DepositStatus
+	TxID       string
 	StartTime  time.Time
 	EndTime    time.Time
+	Offset     int
+	Limit      int
 	RecvWindow time.Duration
 	Timestamp  time.Time
 }
 
 // Deposit represents Deposit data.
 type Deposit struct {
-	InsertTime time.Time
-	Amount     float64
-	Asset      string
-	Status     int
+	ID                    string
+	Amount                float64
+	Asset                 string
+	Network               string
+	Status                DepositStatus
+	Address               string
+	AddressTag            string
+	TxID                  string
+	InsertTime            time.Time
+	CompleteTime          time.Time
+	TransferType          int
+	Confirmations         int
+	RequiredConfirmations int
+	UnlockConfirm         int
 }
 
 // DepositHistory lists deposit data.
-func (b *binance) DepositHistory(hr HistoryRequest) ([]*Deposit, error) {
+func (b *binance) DepositHistory(hr DepositHistoryRequest) ([]*Deposit, error) {
 	return b.Service.DepositHistory(hr)
 }
 
+// WithdrawHistoryRequest represents WithdrawHistory request data.
+type WithdrawHistoryRequest struct {
+	Asset           string
+	WithdrawOrderID string
+	Status          *WithdrawStatus
+	StartTime       time.Time
+	EndTime         time.Time
+	Offset          int
+	Limit           int
+	RecvWindow      time.Duration
+	Timestamp       time.Time
+}
+
 // Withdrawal represents withdrawal data.
 type Withdrawal struct {
-	Amount    float64
-	Address   string
-	TxID      string
-	Asset     string
-	ApplyTime time.Time
-	Status    int
+	ID              string
+	Amount          float64
+	TransactionFee  float64
+	Address         string
+	AddressTag      string
+	TxID            string
+	Asset           string
+	Network         string
+	ApplyTime       time.Time
+	CompleteTime    time.Time
+	Status          WithdrawStatus
+	TransferType    int
+	WithdrawOrderID string
+	Info            string
+	Confirmations   int
 }
 
 // WithdrawHistory lists withdraw data.
-func (b *binance) WithdrawHistory(hr HistoryRequest) ([]*Withdrawal, error) {
+func (b *binance) WithdrawHistory(hr WithdrawHistoryRequest) ([]*Withdrawal, error) {
 	return b.Service.WithdrawHistory(hr)
 }
 
-// Stream represents stream information.
-//
-// Read web docs to get more information about using streams.
-type Stream struct {
-	ListenKey string
+// CoinConfigRequest represents CoinConfig request data.
+type CoinConfigRequest struct {
+	RecvWindow time.Duration
+	Timestamp  time.Time
 }
 
-// StartUserDataStream starts stream and returns Stream with ListenKey.
-func (b *binance) StartUserDataStream() (*Stream, error) {
-	return b.Service.StartUserDataStream()
+// CoinConfig represents deposit and withdraw rules of asset.
+type CoinConfig struct {
+	Asset             string
+	Name              string
+	DepositAllEnable  bool
+	WithdrawAllEnable bool
+	Free              float64
+	Locked            float64
+	Freeze            float64
+	Withdrawing       float64
+	Networks          []*NetworkConfig
 }
 
-// KeepAliveUserDataStream prolongs stream livespan.
-func (b *binance) KeepAliveUserDataStream(s *Stream) error {
-	return b.Service.KeepAliveUserDataStream(s)
+// NetworkConfig represents deposit and withdraw rules of asset on network.
+//
+// SameAddress is set for networks which share deposit address between users,
+// address tag (memo) is required for these.
+type NetworkConfig struct {
+	Network                 string
+	Name                    string
+	IsDefault               bool
+	DepositEnable           bool
+	WithdrawEnable          bool
+	WithdrawFee             float64
+	WithdrawMin             float64
+	WithdrawMax             float64
+	WithdrawIntegerMultiple float64
+	AddressRegex            string
+	MemoRegex               string
+	MinConfirm              int
+	UnlockConfirm           int
+	SameAddress             bool
+	DepositDesc             string
+	WithdrawDesc            string
+}
+
+// CoinConfig returns deposit and withdraw rules of all assets and their networks.
+func (b *binance) CoinConfig(ccr CoinConfigRequest) ([]*CoinConfig, error) {
+	return b.Service.CoinConfig(ccr)
+}
+
+// DepositAddressRequest represents DepositAddress request data.
+//
+// Default network of the asset is used when Network is empty.
+type DepositAddressRequest struct {
+	Asset      string
+	Network    string
+	RecvWindow time.Duration
+	Timestamp  time.Time
 }
 
-// CloseUserDataStream closes opened stream.
-func (b *binance) CloseUserDataStream(s *Stream) error {
-	return b.Service.CloseUserDataStream(s)
+// DepositAddress represents deposit address of asset.
+type DepositAddress struct {
+	Asset   string
+	Address string
+	Tag     string
+	URL     string
 }
 
-type WSEvent struct {
-	Type   string
+// DepositAddress returns deposit address of asset on network.
+func (b *binance) DepositAddress(dar DepositAddressRequest) (*DepositAddress, error) {
+	return b.Service.DepositAddress(dar)
+}
+
+// SubAccountsRequest represents SubAccounts request data.
+//
+// Page starts with 1, Limit is at most 200.
+type SubAccountsRequest struct {
+	Email      string
+	IsFreeze   *bool
+	Page       int
+	Limit      int
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// SubAccount represents sub-account of master account.
+type SubAccount struct {
+	Email                       string
+	IsFreeze                    bool
+	CreateTime                  time.Time
+	IsManagedSubAccount         bool
+	IsAssetManagementSubAccount bool
+}
+
+// SubAccounts lists sub-accounts of master account.
+func (b *binance) SubAccounts(sar SubAccountsRequest) ([]*SubAccount, error) {
+	return b.Service.SubAccounts(sar)
+}
+
+// CreateVirtualSubAccountRequest represents CreateVirtualSubAccount request
+// data. SubAccountString is used as prefix of generated email.
+type CreateVirtualSubAccountRequest struct {
+	SubAccountString string
+	RecvWindow       time.Duration
+	Timestamp        time.Time
+}
+
+// CreateVirtualSubAccount creates virtual sub-account and returns its email.
+func (b *binance) CreateVirtualSubAccount(cvr CreateVirtualSubAccountRequest) (string, error) {
+	return b.Service.CreateVirtualSubAccount(cvr)
+}
+
+// SubAccountRequest represents request data of sub-account queries.
+type SubAccountRequest struct {
+	Email      string
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// SubAccountAssets returns spot balances of sub-account.
+func (b *binance) SubAccountAssets(sar SubAccountRequest) ([]*Balance, error) {
+	return b.Service.SubAccountAssets(sar)
+}
+
+// SubAccountMarginAccount represents margin account of sub-account.
+type SubAccountMarginAccount struct {
+	Email               string
+	MarginLevel         float64
+	TotalAssetOfBtc     float64
+	TotalLiabilityOfBtc float64
+	TotalNetAssetOfBtc  float64
+	ForceLiquidationBar float64
+	MarginCallBar       float64
+	NormalBar           float64
+	Assets              []*Asset
+}
+
+// SubAccountMarginAccount returns margin account of sub-account.
+func (b *binance) SubAccountMarginAccount(sar SubAccountRequest) (*SubAccountMarginAccount, error) {
+	return b.Service.SubAccountMarginAccount(sar)
+}
+
+// SubAccountTransferRequest represents SubAccountTransfer request data.
+//
+// Master account is used when FromEmail or ToEmail is empty, Symbol is
+// required for isolated margin account.
+type SubAccountTransferRequest struct {
+	FromEmail       string
+	ToEmail         string
+	FromAccountType SubAccountType
+	ToAccountType   SubAccountType
+	ClientTranID    string
+	Symbol          string
+	Asset           string
+	Amount          float64
+	RecvWindow      time.Duration
+	Timestamp       time.Time
+}
+
+// SubAccountTransferResult represents result of SubAccountTransfer.
+type SubAccountTransferResult struct {
+	TranID       int64
+	ClientTranID string
+}
+
+// SubAccountTransfer transfers asset between master account and sub-accounts.
+func (b *binance) SubAccountTransfer(str SubAccountTransferRequest) (*SubAccountTransferResult, error) {
+	return b.Service.SubAccountTransfer(str)
+}
+
+// SubAccountTransferHistoryRequest represents SubAccountTransferHistory
+// request data.
+//
+// Page starts with 1, Limit is at most 500.
+type SubAccountTransferHistoryRequest struct {
+	FromEmail    string
+	ToEmail      string
+	ClientTranID string
+	StartTime    time.Time
+	EndTime      time.Time
+	Page         int
+	Limit        int
+	RecvWindow   time.Duration
+	Timestamp    time.Time
+}
+
+// SubAccountTransferHistory represents page of sub-account transfers.
+type SubAccountTransferHistory struct {
+	Total     int
+	Transfers []*SubAccountTransferRecord
+}
+
+// SubAccountTransferRecord represents transfer between master account and
+// sub-accounts.
+type SubAccountTransferRecord struct {
+	TranID          int64
+	ClientTranID    string
+	FromEmail       string
+	ToEmail         string
+	FromAccountType SubAccountType
+	ToAccountType   SubAccountType
+	Asset           string
+	Amount          float64
+	Status          SubAccountTransferStatus
+	Time            time.Time
+}
+
+// SubAccountTransferHistory lists transfers between master account and
+// sub-accounts.
+func (b *binance) SubAccountTransferHistory(sthr SubAccountTransferHistoryRequest) (*SubAccountTransferHistory, error) {
+	return b.Service.SubAccountTransferHistory(sthr)
+}
+
+// DustLogRequest represents DustLog request data.
+type DustLogRequest struct {
+	StartTime  time.Time
+	EndTime    time.Time
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// DustLog represents history of dust conversions.
+type DustLog struct {
+	Total     int
+	Dribblets []*Dribblet
+}
+
+// Dribblet represents single dust conversion of one or more assets.
+type Dribblet struct {
+	TranID                   int64
+	OperateTime              time.Time
+	TotalTransferedAmount    float64
+	TotalServiceChargeAmount float64
+	Details                  []*DribbletDetail
+}
+
+// DribbletDetail represents conversion of single asset to BNB. Amount is in
+// FromAsset, TransferedAmount and ServiceChargeAmount are in BNB.
+type DribbletDetail struct {
+	TranID              int64
+	FromAsset           string
+	Amount              float64
+	TransferedAmount    float64
+	ServiceChargeAmount float64
+	OperateTime         time.Time
+}
+
+// DustLog lists conversions of small balances to BNB.
+func (b *binance) DustLog(dlr DustLogRequest) (*DustLog, error) {
+	return b.Service.DustLog(dlr)
+}
+
+// DustAssets represents assets that can be converted to BNB.
+type DustAssets struct {
+	Details            []*DustAsset
+	TotalTransferBTC   float64
+	TotalTransferBNB   float64
+	DribbletPercentage float64
+}
+
+// DustAsset represents free balance of asset that can be converted to BNB,
+// Exchange is the commission in BNB.
+type DustAsset struct {
+	Asset            string
+	AssetFullName    string
+	AmountFree       float64
+	ToBTC            float64
+	ToBNB            float64
+	ToBNBOffExchange float64
+	Exchange         float64
+}
+
+// DustAssets lists assets that can be converted to BNB.
+func (b *binance) DustAssets(ar AccountRequest) (*DustAssets, error) {
+	return b.Service.DustAssets(ar)
+}
+
+// DustTransferRequest represents DustTransfer request data.
+type DustTransferRequest struct {
+	Assets     []string
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// DustTransferResult represents result of dust conversion, amounts are in BNB.
+type DustTransferResult struct {
+	TotalServiceCharge float64
+	TotalTransfered    float64
+	Results            []*DribbletDetail
+}
+
+// DustTransfer converts small balances of assets to BNB.
+func (b *binance) DustTransfer(dtr DustTransferRequest) (*DustTransferResult, error) {
+	return b.Service.DustTransfer(dtr)
+}
+
+// AssetDividendRequest represents AssetDividendRecord request data.
+//
+// Time range is limited to 180 days, Limit is at most 500.
+type AssetDividendRequest struct {
+	Asset      string
+	StartTime  time.Time
+	EndTime    time.Time
+	Limit      int
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// AssetDividendHistory represents page of asset dividends, newest first.
+type AssetDividendHistory struct {
+	Total     int
+	Dividends []*AssetDividend
+}
+
+// AssetDividend represents asset dividend or distribution.
+type AssetDividend struct {
+	ID      int64
+	TranID  int64
+	Asset   string
+	Amount  float64
+	Info    string
+	DivTime time.Time
+}
+
+// AssetDividendRecord lists asset dividends and distributions.
+func (b *binance) AssetDividendRecord(adr AssetDividendRequest) (*AssetDividendHistory, error) {
+	return b.Service.AssetDividendRecord(adr)
+}
+
+// AssetDetailRequest represents AssetDetail request data, details of all
+// assets are returned when Asset is empty.
+type AssetDetailRequest struct {
+	Asset      string
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// AssetDetail represents deposit and withdraw details of asset.
+type AssetDetail struct {
+	MinWithdrawAmount float64
+	DepositStatus     bool
+	WithdrawFee       float64
+	WithdrawStatus    bool
+	DepositTip        string
+}
+
+// AssetDetail returns deposit and withdraw details of assets by asset name.
+func (b *binance) AssetDetail(adr AssetDetailRequest) (map[string]*AssetDetail, error) {
+	return b.Service.AssetDetail(adr)
+}
+
+// EarnProductsRequest represents FlexibleEarnProducts and LockedEarnProducts
+// request data.
+//
+// Current starts with 1, Size is at most 100.
+type EarnProductsRequest struct {
+	Asset      string
+	Current    int
+	Size       int
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// FlexibleEarnProductList represents page of flexible products.
+type FlexibleEarnProductList struct {
+	Total    int
+	Products []*FlexibleEarnProduct
+}
+
+// FlexibleEarnProduct represents Simple Earn flexible product.
+//
+// TierAnnualPercentageRate maps balance tiers such as "0-5BTC" to their rate.
+type FlexibleEarnProduct struct {
+	ProductID                  string
+	Asset                      string
+	LatestAnnualPercentageRate float64
+	TierAnnualPercentageRate   map[string]float64
+	AirDropPercentageRate      float64
+	CanPurchase                bool
+	CanRedeem                  bool
+	IsSoldOut                  bool
+	Hot                        bool
+	MinPurchaseAmount          float64
+	SubscriptionStartTime      time.Time
+	Status                     string
+}
+
+// FlexibleEarnProducts lists Simple Earn flexible products.
+func (b *binance) FlexibleEarnProducts(epr EarnProductsRequest) (*FlexibleEarnProductList, error) {
+	return b.Service.FlexibleEarnProducts(epr)
+}
+
+// LockedEarnProductList represents page of locked products.
+type LockedEarnProductList struct {
+	Total    int
+	Products []*LockedEarnProduct
+}
+
+// LockedEarnProduct represents Simple Earn locked product, Duration is in days.
+type LockedEarnProduct struct {
+	ProjectID             string
+	Asset                 string
+	RewardAsset           string
+	Duration              int
+	Renewable             bool
+	IsSoldOut             bool
+	APR                   float64
+	Status                string
+	SubscriptionStartTime time.Time
+	ExtraRewardAsset      string
+	ExtraRewardAPR        float64
+	TotalPersonalQuota    float64
+	Minimum               float64
+}
+
+// LockedEarnProducts lists Simple Earn locked products.
+func (b *binance) LockedEarnProducts(epr EarnProductsRequest) (*LockedEarnProductList, error) {
+	return b.Service.LockedEarnProducts(epr)
+}
+
+// SubscribeFlexibleEarnRequest represents SubscribeFlexibleEarn request data.
+//
+// Exchange defaults are used when AutoSubscribe is nil or SourceAccount empty.
+type SubscribeFlexibleEarnRequest struct {
+	ProductID     string
+	Amount        float64
+	AutoSubscribe *bool
+	SourceAccount EarnAccount
+	RecvWindow    time.Duration
+	Timestamp     time.Time
+}
+
+// SubscribeLockedEarnRequest represents SubscribeLockedEarn request data.
+//
+// Exchange defaults are used when AutoSubscribe is nil, SourceAccount or
+// RedeemTo empty.
+type SubscribeLockedEarnRequest struct {
+	ProjectID     string
+	Amount        float64
+	AutoSubscribe *bool
+	SourceAccount EarnAccount
+	RedeemTo      EarnRedeemTo
+	RecvWindow    time.Duration
+	Timestamp     time.Time
+}
+
+// EarnSubscription represents result of subscription, PositionID is set for
+// locked products only.
+type EarnSubscription struct {
+	PurchaseID int64
+	PositionID int64
+	Success    bool
+}
+
+// SubscribeFlexibleEarn subscribes to flexible product.
+func (b *binance) SubscribeFlexibleEarn(sfr SubscribeFlexibleEarnRequest) (*EarnSubscription, error) {
+	return b.Service.SubscribeFlexibleEarn(sfr)
+}
+
+// SubscribeLockedEarn subscribes to locked product.
+func (b *binance) SubscribeLockedEarn(slr SubscribeLockedEarnRequest) (*EarnSubscription, error) {
+	return b.Service.SubscribeLockedEarn(slr)
+}
+
+// RedeemFlexibleEarnRequest represents RedeemFlexibleEarn request data,
+// Amount is ignored when RedeemAll is set.
+type RedeemFlexibleEarnRequest struct {
+	ProductID   string
+	RedeemAll   bool
+	Amount      float64
+	DestAccount EarnAccount
+	RecvWindow  time.Duration
+	Timestamp   time.Time
+}
+
+// RedeemLockedEarnRequest represents RedeemLockedEarn request data.
+type RedeemLockedEarnRequest struct {
+	PositionID int64
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// EarnRedemption represents result of redemption.
+type EarnRedemption struct {
+	RedeemID int64
+	Success  bool
+}
+
+// RedeemFlexibleEarn redeems flexible product.
+func (b *binance) RedeemFlexibleEarn(rfr RedeemFlexibleEarnRequest) (*EarnRedemption, error) {
+	return b.Service.RedeemFlexibleEarn(rfr)
+}
+
+// RedeemLockedEarn redeems locked product position early.
+func (b *binance) RedeemLockedEarn(rlr RedeemLockedEarnRequest) (*EarnRedemption, error) {
+	return b.Service.RedeemLockedEarn(rlr)
+}
+
+// FlexibleEarnPositionsRequest represents FlexibleEarnPositions request data.
+type FlexibleEarnPositionsRequest struct {
+	Asset      string
+	ProductID  string
+	Current    int
+	Size       int
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// FlexibleEarnPositionList represents page of flexible product positions.
+type FlexibleEarnPositionList struct {
+	Total     int
+	Positions []*FlexibleEarnPosition
+}
+
+// FlexibleEarnPosition represents position in flexible product.
+type FlexibleEarnPosition struct {
+	ProductID                      string
+	Asset                          string
+	TotalAmount                    float64
+	LatestAnnualPercentageRate     float64
+	TierAnnualPercentageRate       map[string]float64
+	YesterdayAirdropPercentageRate float64
+	AirDropAsset                   string
+	CanRedeem                      bool
+	CollateralAmount               float64
+	YesterdayRealTimeRewards       float64
+	CumulativeBonusRewards         float64
+	CumulativeRealTimeRewards      float64
+	CumulativeTotalRewards         float64
+	AutoSubscribe                  bool
+}
+
+// FlexibleEarnPositions lists positions in flexible products.
+func (b *binance) FlexibleEarnPositions(fpr FlexibleEarnPositionsRequest) (*FlexibleEarnPositionList, error) {
+	return b.Service.FlexibleEarnPositions(fpr)
+}
+
+// LockedEarnPositionsRequest represents LockedEarnPositions request data.
+type LockedEarnPositionsRequest struct {
+	Asset      string
+	PositionID int64
+	ProjectID  string
+	Current    int
+	Size       int
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// LockedEarnPositionList represents page of locked product positions.
+type LockedEarnPositionList struct {
+	Total     int
+	Positions []*LockedEarnPosition
+}
+
+// LockedEarnPosition represents position in locked product, Duration and
+// AccrualDays are in days.
+type LockedEarnPosition struct {
+	PositionID           int64
+	ParentPositionID     int64
+	ProjectID            string
+	Asset                string
+	Amount               float64
+	PurchaseTime         time.Time
+	Duration             int
+	AccrualDays          int
+	RewardAsset          string
+	APY                  float64
+	RewardAmount         float64
+	ExtraRewardAsset     string
+	ExtraRewardAPR       float64
+	EstExtraRewardAmount float64
+	NextPay              float64
+	NextPayDate          time.Time
+	RedeemAmountEarly    float64
+	RewardsEndDate       time.Time
+	DeliverDate          time.Time
+	RedeemingAmount      float64
+	RedeemTo             EarnRedeemTo
+	CanRedeemEarly       bool
+	CanFastRedemption    bool
+	AutoSubscribe        bool
+	Type                 string
+	Status               string
+}
+
+// LockedEarnPositions lists positions in locked products.
+func (b *binance) LockedEarnPositions(lpr LockedEarnPositionsRequest) (*LockedEarnPositionList, error) {
+	return b.Service.LockedEarnPositions(lpr)
+}
+
+// FlexibleEarnRewardsRequest represents FlexibleEarnRewards request data,
+// Type is required.
+type FlexibleEarnRewardsRequest struct {
+	ProductID  string
+	Asset      string
+	Type       EarnRewardType
+	StartTime  time.Time
+	EndTime    time.Time
+	Current    int
+	Size       int
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// FlexibleEarnRewardHistory represents page of flexible product rewards.
+type FlexibleEarnRewardHistory struct {
+	Total   int
+	Rewards []*FlexibleEarnReward
+}
+
+// FlexibleEarnReward represents reward of flexible product.
+type FlexibleEarnReward struct {
+	ProductID string
+	Asset     string
+	Rewards   float64
+	Type      EarnRewardType
+	Time      time.Time
+}
+
+// FlexibleEarnRewards lists rewards of flexible products.
+func (b *binance) FlexibleEarnRewards(frr FlexibleEarnRewardsRequest) (*FlexibleEarnRewardHistory, error) {
+	return b.Service.FlexibleEarnRewards(frr)
+}
+
+// LockedEarnRewardsRequest represents LockedEarnRewards request data.
+type LockedEarnRewardsRequest struct {
+	PositionID int64
+	Asset      string
+	StartTime  time.Time
+	EndTime    time.Time
+	Current    int
+	Size       int
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// LockedEarnRewardHistory represents page of locked product rewards.
+type LockedEarnRewardHistory struct {
+	Total   int
+	Rewards []*LockedEarnReward
+}
+
+// LockedEarnReward represents reward of locked product position, LockPeriod
+// is in days.
+type LockedEarnReward struct {
+	PositionID int64
+	Asset      string
+	Amount     float64
+	LockPeriod int
+	Time       time.Time
+}
+
+// LockedEarnRewards lists rewards of locked products.
+func (b *binance) LockedEarnRewards(lrr LockedEarnRewardsRequest) (*LockedEarnRewardHistory, error) {
+	return b.Service.LockedEarnRewards(lrr)
+}
+
+// ConvertPairsRequest represents ConvertExchangeInfo request data, at least
+// one of the assets is required.
+type ConvertPairsRequest struct {
+	FromAsset string
+	ToAsset   string
+}
+
+// ConvertPair represents Convert pair with limits of amounts.
+type ConvertPair struct {
+	FromAsset          string
+	ToAsset            string
+	FromAssetMinAmount float64
+	FromAssetMaxAmount float64
+	ToAssetMinAmount   float64
+	ToAssetMaxAmount   float64
+}
+
+// ConvertExchangeInfo lists Convert pairs with their amount limits.
+func (b *binance) ConvertExchangeInfo(cpr ConvertPairsRequest) ([]*ConvertPair, error) {
+	return b.Service.ConvertExchangeInfo(cpr)
+}
+
+// ConvertQuoteRequest represents ConvertGetQuote request data.
+//
+// Either FromAmount or ToAmount is required. ValidTime is 10s, 30s, 1m or
+// 2m, exchange default of 10s is used when it is zero.
+type ConvertQuoteRequest struct {
+	FromAsset  string
+	ToAsset    string
+	FromAmount float64
+	ToAmount   float64
+	WalletType ConvertWallet
+	ValidTime  time.Duration
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// ConvertQuote represents Convert quote. Ratio is ToAsset amount per unit of
+// FromAsset, quote must be accepted before ValidTime.
+type ConvertQuote struct {
+	QuoteID      string
+	Ratio        float64
+	InverseRatio float64
+	ValidTime    time.Time
+	FromAmount   float64
+	ToAmount     float64
+}
+
+// ConvertGetQuote requests Convert quote.
+func (b *binance) ConvertGetQuote(cqr ConvertQuoteRequest) (*ConvertQuote, error) {
+	return b.Service.ConvertGetQuote(cqr)
+}
+
+// ConvertAcceptRequest represents ConvertAcceptQuote request data.
+type ConvertAcceptRequest struct {
+	QuoteID    string
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// ConvertOrder represents Convert order. ConvertAcceptQuote sets only
+// OrderID, Status and CreateTime.
+type ConvertOrder struct {
+	OrderID      int64
+	QuoteID      string
+	Status       ConvertStatus
+	FromAsset    string
+	FromAmount   float64
+	ToAsset      string
+	ToAmount     float64
+	Ratio        float64
+	InverseRatio float64
+	CreateTime   time.Time
+}
+
+// ConvertAcceptQuote accepts Convert quote before it expires.
+func (b *binance) ConvertAcceptQuote(car ConvertAcceptRequest) (*ConvertOrder, error) {
+	return b.Service.ConvertAcceptQuote(car)
+}
+
+// ConvertOrderStatusRequest represents ConvertOrderStatus request data,
+// either OrderID or QuoteID is required.
+type ConvertOrderStatusRequest struct {
+	OrderID    int64
+	QuoteID    string
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// ConvertOrderStatus returns Convert order by order or quote ID.
+func (b *binance) ConvertOrderStatus(cosr ConvertOrderStatusRequest) (*ConvertOrder, error) {
+	return b.Service.ConvertOrderStatus(cosr)
+}
+
+// ConvertTradeHistoryRequest represents ConvertTradeHistory request data.
+//
+// StartTime and EndTime are required and at most 30 days apart, Limit is at
+// most 1000.
+type ConvertTradeHistoryRequest struct {
+	StartTime  time.Time
+	EndTime    time.Time
+	Limit      int
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// ConvertTradeHistory represents page of Convert orders, MoreData is set when
+// the range holds more orders than Limit.
+type ConvertTradeHistory struct {
+	Orders    []*ConvertOrder
+	StartTime time.Time
+	EndTime   time.Time
+	Limit     int
+	MoreData  bool
+}
+
+// ConvertTradeHistory lists Convert orders.
+func (b *binance) ConvertTradeHistory(cthr ConvertTradeHistoryRequest) (*ConvertTradeHistory, error) {
+	return b.Service.ConvertTradeHistory(cthr)
+}
+
+// TransferResult represents result of transfer.
+type TransferResult struct {
+	TranID int64
+}
+
+// UniversalTransferRequest represents UniversalTransfer request data.
+//
+// FromSymbol and ToSymbol are required for transfers from and to isolated
+// margin wallet.
+type UniversalTransferRequest struct {
+	Type       TransferType
+	Asset      string
+	Amount     float64
+	FromSymbol string
+	ToSymbol   string
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// UniversalTransfer transfers asset between wallets.
+func (b *binance) UniversalTransfer(utr UniversalTransferRequest) (*TransferResult, error) {
+	return b.Service.UniversalTransfer(utr)
+}
+
+// UniversalTransferHistoryRequest represents UniversalTransferHistory request
+// data. Current is page number starting with 1, Size is page size.
+type UniversalTransferHistoryRequest struct {
+	Type       TransferType
+	StartTime  time.Time
+	EndTime    time.Time
+	Current    int
+	Size       int
+	FromSymbol string
+	ToSymbol   string
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// TransferHistory represents page of universal transfers.
+type TransferHistory struct {
+	Total     int
+	Transfers []*Transfer
+}
+
+// Transfer represents universal transfer.
+type Transfer struct {
+	TranID int64
+	Asset  string
+	Amount float64
+	Type   TransferType
+	Status TransferStatus
+	Time   time.Time
+}
+
+// UniversalTransferHistory lists universal transfers of given type.
+func (b *binance) UniversalTransferHistory(uthr UniversalTransferHistoryRequest) (*TransferHistory, error) {
+	return b.Service.UniversalTransferHistory(uthr)
+}
+
+// MarginTransferRequest represents MarginTransfer request data.
+type MarginTransferRequest struct {
+	Asset      string
+	Amount     float64
+	Type       MarginTransferType
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// MarginTransfer transfers asset between spot and cross margin wallet.
+func (b *binance) MarginTransfer(mtr MarginTransferRequest) (*TransferResult, error) {
+	return b.Service.MarginTransfer(mtr)
+}
+
+// IsolatedMarginTransferRequest represents IsolatedMarginTransfer request data.
+type IsolatedMarginTransferRequest struct {
+	Asset      string
+	Symbol     string
+	From       MarginWallet
+	To         MarginWallet
+	Amount     float64
+	RecvWindow time.Duration
+	Timestamp  time.Time
+}
+
+// IsolatedMarginTransfer transfers asset between spot and isolated margin wallet.
+func (b *binance) IsolatedMarginTransfer(imtr IsolatedMarginTransferRequest) (*TransferResult, error) {
+	return b.Service.IsolatedMarginTransfer(imtr)
+}
+
+// MarginTransferHistoryRequest represents MarginTransferHistory request data.
+//
+// Transfers of isolated margin pair are listed when IsolatedSymbol is set,
+// cross margin transfers otherwise.
+type MarginTransferHistoryRequest struct {
+	Asset          string
+	Direction      MarginTransferDirection
+	IsolatedSymbol string
+	StartTime      time.Time
+	EndTime        time.Time
+	Current        int
+	Size           int
+	RecvWindow     time.Duration
+	Timestamp      time.Time
+}
+
+// MarginTransferHistory represents page of margin transfers.
+type MarginTransferHistory struct {
+	Total     int
+	Transfers []*MarginTransferRecord
+}
+
+// MarginTransferRecord represents margin transfer.
+type MarginTransferRecord struct {
+	TranID    int64
+	Asset     string
+	Amount    float64
+	Direction MarginTransferDirection
+	Status    TransferStatus
+	From      MarginWallet
+	To        MarginWallet
+	Time      time.Time
+}
+
+// MarginTransferHistory lists cross or isolated margin transfers.
+func (b *binance) MarginTransferHistory(mthr MarginTransferHistoryRequest) (*MarginTransferHistory, error) {
+	return b.Service.MarginTransferHistory(mthr)
+}
+
+// Stream represents stream information.
+//
+// Read web docs to get more information about using streams.
+type Stream struct {
+	ListenKey string
+}
+
+// StartUserDataStream starts stream and returns Stream with ListenKey.
+func (b *binance) StartUserDataStream() (*Stream, error) {
+	return b.Service.StartUserDataStream()
+}
+
+// KeepAliveUserDataStream prolongs stream livespan.
+func (b *binance) KeepAliveUserDataStream(s *Stream) error {
+	return b.Service.KeepAliveUserDataStream(s)
+}
+
+// CloseUserDataStream closes opened stream.
+func (b *binance) CloseUserDataStream(s *Stream) error {
+	return b.Service.CloseUserDataStream(s)
+}
+
+type WSEvent struct {
+	Type   string
 	Time   time.Time
 	Symbol string
 }
@@ -617,6 +2351,8 @@ func (b *binance) KlineWebsocket(kwr KlineWebsocketRequest) (chan *KlineEvent, c
 
 type TradeWebsocketRequest struct {
 	Symbol string
+	// Ctx closes the stream when done, context of service is used when nil.
+	Ctx context.Context
 }
 
 func (b *binance) TradeWebsocket(twr TradeWebsocketRequest) (chan *AggTradeEvent, chan struct{}, error) {
@@ -651,14 +2387,85 @@ func (b *binance) OpenMarginOrders(oor OpenOrdersRequest) ([]*ExecutedOrder, err
 	return b.Service.OpenMarginOrders(oor)
 }
 
+// CancelOpenMarginOrders cancels all open margin orders of symbol.
+func (b *binance) CancelOpenMarginOrders(oor OpenOrdersRequest) ([]*CanceledOrder, error) {
+	return b.Service.CancelOpenMarginOrders(oor)
+}
+
+// NewMarginOCO places margin OCO order list.
+func (b *binance) NewMarginOCO(nor NewMarginOCORequest) (*OrderList, error) {
+	return b.Service.NewMarginOCO(nor)
+}
+
+// QueryMarginOCO returns margin OCO order list.
+func (b *binance) QueryMarginOCO(olr OrderListRequest) (*OrderList, error) {
+	return b.Service.QueryMarginOCO(olr)
+}
+
+// CancelMarginOCO cancels margin OCO order list.
+func (b *binance) CancelMarginOCO(olr OrderListRequest) (*OrderList, error) {
+	return b.Service.CancelMarginOCO(olr)
+}
+
+// MarginOrderCount returns usage of margin order rate limits.
+func (b *binance) MarginOrderCount(mocr MarginOrderCountRequest) ([]*OrderCount, error) {
+	return b.Service.MarginOrderCount(mocr)
+}
+
 func (b *binance) AllMarginOrders(aor AllOrdersRequest) ([]*ExecutedOrder, error) {
 	return b.Service.AllMarginOrders(aor)
 }
 
+// MarginAccount returns cross margin account data.
 func (b *binance) MarginAccount(ar AccountRequest) (*MarginAccount, error) {
 	return b.Service.MarginAccount(ar)
 }
 
+// IsolatedMarginAccount returns isolated margin account data.
+func (b *binance) IsolatedMarginAccount(imar IsolatedMarginAccountRequest) (*IsolatedMarginAccount, error) {
+	return b.Service.IsolatedMarginAccount(imar)
+}
+
+// EnableIsolatedMarginAccount enables isolated margin account of symbol.
+func (b *binance) EnableIsolatedMarginAccount(isr IsolatedSymbolRequest) error {
+	return b.Service.EnableIsolatedMarginAccount(isr)
+}
+
+// DisableIsolatedMarginAccount disables isolated margin account of symbol.
+func (b *binance) DisableIsolatedMarginAccount(isr IsolatedSymbolRequest) error {
+	return b.Service.DisableIsolatedMarginAccount(isr)
+}
+
+// AllIsolatedMarginSymbols lists isolated margin pairs, or the one given by Symbol.
+func (b *binance) AllIsolatedMarginSymbols(isr IsolatedSymbolRequest) ([]*IsolatedMarginSymbol, error) {
+	return b.Service.AllIsolatedMarginSymbols(isr)
+}
+
+// MarginInterestHistory lists interest charged on margin loans.
+func (b *binance) MarginInterestHistory(ihr InterestHistoryRequest) (*InterestHistory, error) {
+	return b.Service.MarginInterestHistory(ihr)
+}
+
+// ForceLiquidations lists force liquidation orders.
+func (b *binance) ForceLiquidations(flr ForceLiquidationRequest) (*ForceLiquidationHistory, error) {
+	return b.Service.ForceLiquidations(flr)
+}
+
+// MarginInterestRateHistory lists daily interest rates of asset.
+func (b *binance) MarginInterestRateHistory(irhr InterestRateHistoryRequest) ([]*InterestRate, error) {
+	return b.Service.MarginInterestRateHistory(irhr)
+}
+
+// CrossMarginCollateralRatio returns collateral discount rates of cross margin assets.
+func (b *binance) CrossMarginCollateralRatio() ([]*CollateralRatio, error) {
+	return b.Service.CrossMarginCollateralRatio()
+}
+
+// MarginPriceIndex returns margin price index of symbol.
+func (b *binance) MarginPriceIndex(mpir MarginPriceIndexRequest) (*MarginPriceIndex, error) {
+	return b.Service.MarginPriceIndex(mpir)
+}
+
 func (b *binance) MyMarginTrades(mtr MyTradesRequest) ([]*Trade, error) {
 	return b.Service.MyMarginTrades(mtr)
 }
@@ -667,9 +2474,29 @@ func (b *binance) AllMarginAssets(ar AccountRequest) ([]*MarginAsset, error) {
 	return b.Service.AllMarginAssets(ar)
 }
 
-func (b *binance) MaxBorrow(mbr MaxMarginRequest) (float64, error) {
+func (b *binance) MaxBorrow(mbr MaxMarginRequest) (*MaxBorrowable, error) {
 	return b.Service.MaxBorrow(mbr)
 }
 func (b *binance) MaxTransfer(mbr MaxMarginRequest) (float64, error) {
 	return b.Service.MaxTransfer(mbr)
 }
+
+// MarginBorrow borrows asset to cross or isolated margin account.
+func (b *binance) MarginBorrow(mlr MarginLoanRequest) (*MarginLoanResult, error) {
+	return b.Service.MarginBorrow(mlr)
+}
+
+// MarginRepay repays borrowed asset of cross or isolated margin account.
+func (b *binance) MarginRepay(mlr MarginLoanRequest) (*MarginLoanResult, error) {
+	return b.Service.MarginRepay(mlr)
+}
+
+// MarginLoanRecords lists borrow records.
+func (b *binance) MarginLoanRecords(mlrr MarginLoanRecordsRequest) (*MarginLoanHistory, error) {
+	return b.Service.MarginLoanRecords(mlrr)
+}
+
+// MarginRepayRecords lists repay records.
+func (b *binance) MarginRepayRecords(mlrr MarginLoanRecordsRequest) (*MarginLoanHistory, error) {
+	return b.Service.MarginRepayRecords(mlrr)
+}
@@ -0,0 +1,119 @@
+package binance
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// canonicalRequest holds request in the form it is signed and sent.
+//
+// Parameters of GET requests are sent in query string, other methods send
+// them as application/x-www-form-urlencoded body. Parameters are sorted by
+// key, so the same parameters always produce the same request.
+type canonicalRequest struct {
+	Method    string
+	URL       string
+	APIKey    string
+	Query     string
+	Body      string
+	Signature string
+}
+
+func newCanonicalRequest(method string, rawURL string, params url.Values) *canonicalRequest {
+	cr := &canonicalRequest{
+		Method: method,
+		URL:    rawURL,
+	}
+	// url.Values.Encode sorts parameters by key
+	if method == "GET" {
+		cr.Query = params.Encode()
+	} else {
+		cr.Body = params.Encode()
+	}
+	return cr
+}
+
+// payload returns signed string, query string concatenated with body as
+// specified by Binance.
+func (cr *canonicalRequest) payload() string {
+	return cr.Query + cr.Body
+}
+
+// sign signs payload, error is returned when signer returns empty
+// signature, so that unsigned request is not sent.
+func (cr *canonicalRequest) sign(signer Signer) error {
+	if signer == nil {
+		return errors.New("signer not set")
+	}
+	cr.Signature = signer.Sign([]byte(cr.payload()))
+	if cr.Signature == "" {
+		return errors.New("unable to sign request")
+	}
+	return nil
+}
+
+// encode returns query string and body with encoded signature appended to
+// the part holding parameters.
+func (cr *canonicalRequest) encode(signature string) (string, string) {
+	query, body := cr.Query, cr.Body
+	if cr.Signature == "" {
+		return query, body
+	}
+	param := "signature=" + signature
+	if body != "" || query == "" && cr.Method != "GET" {
+		return query, joinParams(body, param)
+	}
+	return joinParams(query, param), body
+}
+
+func (cr *canonicalRequest) httpRequest() (*http.Request, error) {
+	// RSA and Ed25519 signatures are base64 and have to be escaped
+	query, body := cr.encode(url.QueryEscape(cr.Signature))
+	var bodyReader io.Reader
+	if body != "" {
+		bodyReader = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(cr.Method, cr.URL, bodyReader)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create request")
+	}
+	req.URL.RawQuery = query
+	if body != "" {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if cr.APIKey != "" {
+		req.Header.Set("X-MBX-APIKEY", cr.APIKey)
+	}
+	return req, nil
+}
+
+// dump returns request as it is sent, with API key, signature and listen key
+// redacted.
+func (cr *canonicalRequest) dump() string {
+	query, body := cr.encode(redacted)
+	query, body = redactParams(query), redactParams(body)
+	var b strings.Builder
+	b.WriteString(cr.Method + " " + cr.URL)
+	if query != "" {
+		b.WriteString("?" + query)
+	}
+	if cr.APIKey != "" {
+		fmt.Fprintf(&b, "\nX-MBX-APIKEY: %s", redactSecret(cr.APIKey))
+	}
+	if body != "" {
+		b.WriteString("\nContent-Type: application/x-www-form-urlencoded\n\n" + body)
+	}
+	return b.String()
+}
+
+func joinParams(params, param string) string {
+	if params == "" {
+		return param
+	}
+	return params + "&" + param
+}
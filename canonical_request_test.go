@@ -0,0 +1,97 @@
+package binance
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestCanonicalRequestSignature(t *testing.T) {
+	// mixed query string and body example of Binance API documentation
+	signer := &HmacSigner{
+		Key: []byte("NhqPtmdSJYdKjVHjA7PZj4Mge3R5YNiP1e3UZjInClVN65XAbvqqM6A7H5fATj0j"),
+	}
+	cr := &canonicalRequest{
+		Method: "POST",
+		URL:    "https://api.binance.com/api/v3/order",
+		Query:  "symbol=LTCBTC&side=BUY&type=LIMIT&timeInForce=GTC",
+		Body:   "quantity=1&price=0.1&recvWindow=5000&timestamp=1499827319559",
+	}
+	cr.sign(signer)
+	if cr.Signature != "0fd168b8ddb4876a0358a8d14d0c9f3da0e9b20c5d52b2a00fcf7d1c602f9a77" {
+		t.Errorf("invalid signature: %s", cr.Signature)
+	}
+	query, body := cr.encode(cr.Signature)
+	if query != cr.Query || body != cr.Body+"&signature="+cr.Signature {
+		t.Errorf("signature not appended to body: %q, %q", query, body)
+	}
+}
+
+func TestNewCanonicalRequest(t *testing.T) {
+	params := url.Values{}
+	for _, kv := range [][2]string{{"timestamp", "1499827319559"}, {"symbol", "LTCBTC"}, {"side", "BUY"}, {"quantity", "1"}} {
+		params.Set(kv[0], kv[1])
+	}
+	want := "quantity=1&side=BUY&symbol=LTCBTC&timestamp=1499827319559"
+
+	cr := newCanonicalRequest("POST", "https://api.binance.com/api/v3/order", params)
+	if cr.Query != "" || cr.Body != want {
+		t.Errorf("unexpected POST request: %#v", cr)
+	}
+	cr = newCanonicalRequest("GET", "https://api.binance.com/api/v3/order", params)
+	if cr.Query != want || cr.Body != "" {
+		t.Errorf("unexpected GET request: %#v", cr)
+	}
+}
+
+func TestCanonicalRequestDump(t *testing.T) {
+	cr := &canonicalRequest{
+		Method: "POST",
+		URL:    "https://api.binance.com/api/v3/order",
+		APIKey: "vmPUZE6mv9SD5VNHk4HlWFsOr6aKE2zvsw0MuIgwCIPy6utIco14y7Ju91duEh8A",
+		Body:   "symbol=LTCBTC&timestamp=1499827319559",
+	}
+	cr.sign(&HmacSigner{Key: []byte("secret")})
+	dump := cr.dump()
+	if strings.Contains(dump, cr.Signature) || strings.Contains(dump, cr.APIKey) {
+		t.Errorf("secrets not redacted: %s", dump)
+	}
+	want := "POST https://api.binance.com/api/v3/order\n" +
+		"X-MBX-APIKEY: vmPU[redacted]\n" +
+		"Content-Type: application/x-www-form-urlencoded\n\n" +
+		"symbol=LTCBTC&timestamp=1499827319559&signature=[redacted]"
+	if dump != want {
+		t.Errorf("unexpected dump:\n%s", dump)
+	}
+}
+
+func TestRequestSendsBody(t *testing.T) {
+	signer := &HmacSigner{Key: []byte("secret")}
+	var contentType, apiKey, query string
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		apiKey = r.Header.Get("X-MBX-APIKEY")
+		query = r.URL.RawQuery
+		body, _ := ioutil.ReadAll(r.Body)
+		form, _ = url.ParseQuery(string(body))
+	}))
+	defer server.Close()
+
+	as := NewAPIService(server.URL, "key", signer, nil, context.Background()).(*apiService)
+	res, err := as.request("POST", "api/v3/order", map[string]string{"symbol": "LTCBTC", "timestamp": "1499827319559"}, true, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if contentType != "application/x-www-form-urlencoded" || apiKey != "key" || query != "" {
+		t.Errorf("unexpected request: %q, %q, %q", contentType, apiKey, query)
+	}
+	if form.Get("symbol") != "LTCBTC" || form.Get("signature") != signer.Sign([]byte("symbol=LTCBTC&timestamp=1499827319559")) {
+		t.Errorf("unexpected body: %v", form)
+	}
+}
@@ -0,0 +1,95 @@
+package binance
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// clientOrderIDMaxLen is the maximum length of client order ID accepted by API.
+const clientOrderIDMaxLen = 36
+
+// ClientOrderIDGenerator produces client order IDs for new orders.
+type ClientOrderIDGenerator interface {
+	// NextID returns new unique client order ID for given strategy tag.
+	NextID(tag string) string
+}
+
+// SequenceIDGenerator builds client order IDs from prefix, strategy tag and
+// monotonic sequence number, e.g. "bot1-grid-1a".
+//
+// Only characters allowed by API (letters, digits and ".:/_") are kept in
+// prefix and tag, other characters are replaced with "_". Tag and then prefix
+// are shortened when ID would exceed 36 characters. Sequence is base36 encoded
+// and never truncated, so IDs stay unique for the same prefix.
+type SequenceIDGenerator struct {
+	Prefix string
+	seq    uint64
+}
+
+// NewSequenceIDGenerator returns SequenceIDGenerator which issues sequence
+// numbers starting right after last.
+//
+// Use last recovered with ParseClientOrderID from previously placed orders to
+// continue the sequence after restart.
+func NewSequenceIDGenerator(prefix string, last uint64) *SequenceIDGenerator {
+	return &SequenceIDGenerator{
+		Prefix: prefix,
+		seq:    last,
+	}
+}
+
+// NextID returns new unique client order ID for given strategy tag.
+func (g *SequenceIDGenerator) NextID(tag string) string {
+	seq := atomic.AddUint64(&g.seq, 1)
+	return formatClientOrderID(g.Prefix, tag, seq)
+}
+
+// Last returns last issued sequence number.
+func (g *SequenceIDGenerator) Last() uint64 {
+	return atomic.LoadUint64(&g.seq)
+}
+
+// ParseClientOrderID splits ID produced by SequenceIDGenerator into its
+// prefix, strategy tag and sequence number.
+func ParseClientOrderID(id string) (prefix string, tag string, seq uint64, err error) {
+	parts := strings.Split(id, "-")
+	if len(parts) != 3 {
+		return "", "", 0, errors.New(fmt.Sprintf("unable to parse client order ID: %s", id))
+	}
+	seq, err = strconv.ParseUint(parts[2], 36, 64)
+	if err != nil {
+		return "", "", 0, errors.Wrap(err, fmt.Sprintf("unable to parse client order ID sequence: %s", id))
+	}
+	return parts[0], parts[1], seq, nil
+}
+
+func formatClientOrderID(prefix, tag string, seq uint64) string {
+	prefix = sanitizeClientOrderIDPart(prefix)
+	tag = sanitizeClientOrderIDPart(tag)
+	s := strconv.FormatUint(seq, 36)
+
+	room := clientOrderIDMaxLen - len(s) - 2
+	if len(prefix)+len(tag) > room {
+		if len(prefix) > room {
+			prefix = prefix[:room]
+		}
+		tag = tag[:room-len(prefix)]
+	}
+	return prefix + "-" + tag + "-" + s
+}
+
+func sanitizeClientOrderIDPart(part string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r == '.' || r == ':' || r == '/' || r == '_':
+			return r
+		}
+		return '_'
+	}, part)
+}
@@ -0,0 +1,62 @@
+package binance
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var clientOrderIDPattern = regexp.MustCompile(`^[\.A-Z\:/a-z0-9_-]{1,36}$`)
+
+func TestSequenceIDGenerator(t *testing.T) {
+	g := NewSequenceIDGenerator("bot1", 34)
+	id := g.NextID("grid")
+	if id != "bot1-grid-z" {
+		t.Errorf("unexpected ID: %s", id)
+	}
+	id = g.NextID("grid")
+	if id != "bot1-grid-10" {
+		t.Errorf("unexpected ID: %s", id)
+	}
+	if g.Last() != 36 {
+		t.Errorf("unexpected last sequence: %d", g.Last())
+	}
+
+	prefix, tag, seq, err := ParseClientOrderID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prefix != "bot1" || tag != "grid" || seq != 36 {
+		t.Errorf("unexpected parse result: %s %s %d", prefix, tag, seq)
+	}
+}
+
+func TestSequenceIDGeneratorCharset(t *testing.T) {
+	g := NewSequenceIDGenerator("my bot-1", ^uint64(0)-1)
+	id := g.NextID(strings.Repeat("mean-reversion ", 5))
+	if !clientOrderIDPattern.MatchString(id) {
+		t.Errorf("ID doesn't match API charset: %s", id)
+	}
+	prefix, tag, seq, err := ParseClientOrderID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prefix != "my_bot_1" {
+		t.Errorf("unexpected prefix: %s", prefix)
+	}
+	if tag != "mean_reversio" {
+		t.Errorf("unexpected tag: %s", tag)
+	}
+	if seq != ^uint64(0) {
+		t.Errorf("unexpected sequence: %d", seq)
+	}
+}
+
+func TestParseClientOrderIDInvalid(t *testing.T) {
+	if _, _, _, err := ParseClientOrderID("web_1234"); err == nil {
+		t.Errorf("expected error for foreign ID")
+	}
+	if _, _, _, err := ParseClientOrderID("a-b-!"); err == nil {
+		t.Errorf("expected error for invalid sequence")
+	}
+}
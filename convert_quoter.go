@@ -0,0 +1,87 @@
+package binance
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const defaultQuoteSafetyMargin = 500 * time.Millisecond
+
+var (
+	// ErrQuoteOutOfTolerance is returned when quote ratio is worse than
+	// reference ratio by more than tolerance.
+	ErrQuoteOutOfTolerance = errors.New("convert quote outside price tolerance")
+	// ErrQuoteExpired is returned when quote expires before it can be accepted.
+	ErrQuoteExpired = errors.New("convert quote expired")
+)
+
+// ConvertQuoter requests Convert quotes and accepts them when their price is
+// within tolerance of reference price.
+type ConvertQuoter struct {
+	Binance Binance
+	// Tolerance is accepted relative shortfall of quote ratio below reference
+	// ratio, 0.01 accepts quotes at most 1% worse.
+	Tolerance float64
+	// SafetyMargin is minimum time left before quote expires for the quote to
+	// be accepted, 500ms by default.
+	SafetyMargin time.Duration
+	// Now returns current time, time.Now by default.
+	Now func() time.Time
+}
+
+// NewConvertQuoter returns ConvertQuoter with default safety margin.
+func NewConvertQuoter(b Binance, tolerance float64) *ConvertQuoter {
+	return &ConvertQuoter{
+		Binance:      b,
+		Tolerance:    tolerance,
+		SafetyMargin: defaultQuoteSafetyMargin,
+		Now:          time.Now,
+	}
+}
+
+// Convert requests quote and accepts it when its ratio is not below refRatio
+// by more than Tolerance and the quote doesn't expire within SafetyMargin.
+// RefRatio is expected ToAsset amount per unit of FromAsset.
+//
+// Quote is returned together with ErrQuoteOutOfTolerance and ErrQuoteExpired,
+// so that rejected quotes can be logged.
+func (cq *ConvertQuoter) Convert(cqr ConvertQuoteRequest, refRatio float64) (*ConvertOrder, *ConvertQuote, error) {
+	if refRatio <= 0 {
+		return nil, nil, errors.Errorf("invalid reference ratio %v", refRatio)
+	}
+	if cqr.Timestamp.IsZero() {
+		cqr.Timestamp = cq.now()
+	}
+	quote, err := cq.Binance.ConvertGetQuote(cqr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if minRatio := refRatio * (1 - cq.Tolerance); quote.Ratio < minRatio {
+		return nil, quote, errors.Wrapf(ErrQuoteOutOfTolerance, "ratio %v below %v", quote.Ratio, minRatio)
+	}
+	margin := cq.SafetyMargin
+	if margin == 0 {
+		margin = defaultQuoteSafetyMargin
+	}
+	now := cq.now()
+	if !now.Add(margin).Before(quote.ValidTime) {
+		return nil, quote, errors.Wrapf(ErrQuoteExpired, "quote %s valid until %s", quote.QuoteID, quote.ValidTime)
+	}
+	order, err := cq.Binance.ConvertAcceptQuote(ConvertAcceptRequest{
+		QuoteID:    quote.QuoteID,
+		RecvWindow: cqr.RecvWindow,
+		Timestamp:  now,
+	})
+	if err != nil {
+		return nil, quote, err
+	}
+	return order, quote, nil
+}
+
+func (cq *ConvertQuoter) now() time.Time {
+	if cq.Now == nil {
+		return time.Now()
+	}
+	return cq.Now()
+}
@@ -0,0 +1,52 @@
+package binance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/binance-exchange/go-binance"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestConvertQuoter(t *testing.T) {
+	binanceService := &ServiceMock{}
+	cq := binance.NewConvertQuoter(binance.NewBinance(binanceService), 0.01)
+	now := time.Unix(1623319451, 0)
+	cq.Now = func() time.Time { return now }
+
+	cqr := binance.ConvertQuoteRequest{FromAsset: "BTC", ToAsset: "USDT", FromAmount: 0.1}
+	quote := &binance.ConvertQuote{QuoteID: "12415572564", Ratio: 38000, ValidTime: now.Add(10 * time.Second)}
+	binanceService.On("ConvertGetQuote", mock.AnythingOfType("binance.ConvertQuoteRequest")).Return(quote, nil).Once()
+	binanceService.On("ConvertAcceptQuote", binance.ConvertAcceptRequest{QuoteID: "12415572564", Timestamp: now}).
+		Return(&binance.ConvertOrder{OrderID: 933256278426274426, Status: binance.ConvertProcessing}, nil).Once()
+
+	order, q, err := cq.Convert(cqr, 38300)
+	assert.Nil(t, err)
+	assert.Equal(t, quote, q)
+	assert.Equal(t, int64(933256278426274426), order.OrderID)
+	binanceService.AssertExpectations(t)
+}
+
+func TestConvertQuoterRejects(t *testing.T) {
+	binanceService := &ServiceMock{}
+	cq := binance.NewConvertQuoter(binance.NewBinance(binanceService), 0.01)
+	now := time.Unix(1623319451, 0)
+	cq.Now = func() time.Time { return now }
+	cqr := binance.ConvertQuoteRequest{FromAsset: "BTC", ToAsset: "USDT", FromAmount: 0.1}
+
+	binanceService.On("ConvertGetQuote", mock.AnythingOfType("binance.ConvertQuoteRequest")).
+		Return(&binance.ConvertQuote{QuoteID: "1", Ratio: 37000, ValidTime: now.Add(10 * time.Second)}, nil).Once()
+	_, q, err := cq.Convert(cqr, 38000)
+	assert.Equal(t, binance.ErrQuoteOutOfTolerance, errors.Cause(err))
+	assert.Equal(t, "1", q.QuoteID)
+
+	binanceService.On("ConvertGetQuote", mock.AnythingOfType("binance.ConvertQuoteRequest")).
+		Return(&binance.ConvertQuote{QuoteID: "2", Ratio: 38000, ValidTime: now.Add(100 * time.Millisecond)}, nil).Once()
+	_, _, err = cq.Convert(cqr, 38000)
+	assert.Equal(t, binance.ErrQuoteExpired, errors.Cause(err))
+
+	binanceService.AssertNotCalled(t, "ConvertAcceptQuote", mock.Anything)
+	binanceService.AssertExpectations(t)
+}
@@ -0,0 +1,17 @@
+package binance
+
+// ConvertWallet represents wallet used by Convert.
+type ConvertWallet string
+
+// ConvertStatus represents Convert order status enum.
+type ConvertStatus string
+
+var (
+	ConvertWalletSpot    = ConvertWallet("SPOT")
+	ConvertWalletFunding = ConvertWallet("FUNDING")
+
+	ConvertProcessing    = ConvertStatus("PROCESS")
+	ConvertAcceptSuccess = ConvertStatus("ACCEPT_SUCCESS")
+	ConvertSuccess       = ConvertStatus("SUCCESS")
+	ConvertFail          = ConvertStatus("FAIL")
+)
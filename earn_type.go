@@ -0,0 +1,24 @@
+package binance
+
+// EarnAccount represents source or destination account of Simple Earn
+// subscription and redemption.
+type EarnAccount string
+
+// EarnRedeemTo represents destination of locked product at the end of term.
+type EarnRedeemTo string
+
+// EarnRewardType represents flexible product reward type enum.
+type EarnRewardType string
+
+var (
+	EarnAccountSpot = EarnAccount("SPOT")
+	EarnAccountFund = EarnAccount("FUND")
+	EarnAccountAll  = EarnAccount("ALL")
+
+	EarnRedeemToSpot     = EarnRedeemTo("SPOT")
+	EarnRedeemToFlexible = EarnRedeemTo("FLEXIBLE")
+
+	EarnRewardBonus    = EarnRewardType("BONUS")
+	EarnRewardRealTime = EarnRewardType("REALTIME")
+	EarnRewardRewards  = EarnRewardType("REWARDS")
+)
@@ -144,7 +144,7 @@ func main() {
 	}
 	fmt.Printf("%#v\n", res7)
 
-	res9, err := b.DepositHistory(binance.HistoryRequest{
+	res9, err := b.DepositHistory(binance.DepositHistoryRequest{
 		Timestamp:  time.Now(),
 		RecvWindow: 5 * time.Second,
 	})
@@ -153,7 +153,7 @@ func main() {
 	}
 	fmt.Printf("%#v\n", res9)
 
-	res8, err := b.WithdrawHistory(binance.HistoryRequest{
+	res8, err := b.WithdrawHistory(binance.WithdrawHistoryRequest{
 		Timestamp:  time.Now(),
 		RecvWindow: 5 * time.Second,
 	})
@@ -0,0 +1,118 @@
+package binance
+
+import (
+	"github.com/pkg/errors"
+)
+
+// TradeRole represents maker or taker role of order in a trade.
+type TradeRole string
+
+var (
+	RoleMaker = TradeRole("MAKER")
+	RoleTaker = TradeRole("TAKER")
+)
+
+// Fee represents predicted commission of order.
+type Fee struct {
+	Asset  string
+	Amount float64
+	// Rate is the effective rate charged on traded amount.
+	Rate float64
+}
+
+// FeeCalculator predicts commission of orders of a single symbol from rates
+// returned by Commission.
+//
+// Commission is charged in the received asset: base asset for buy orders and
+// quote asset for sell orders. When discount is enabled for account and symbol
+// and DiscountAssetPrice is set, commission is paid in discount asset instead
+// and the standard rate is multiplied by the discount.
+type FeeCalculator struct {
+	Commission *AccountCommission
+	BaseAsset  string
+	QuoteAsset string
+	// DiscountAssetPrice is price of discount asset in QuoteAsset.
+	DiscountAssetPrice float64
+}
+
+// NewFeeCalculator returns FeeCalculator without discount asset price.
+func NewFeeCalculator(ac *AccountCommission, baseAsset, quoteAsset string) *FeeCalculator {
+	return &FeeCalculator{
+		Commission: ac,
+		BaseAsset:  baseAsset,
+		QuoteAsset: quoteAsset,
+	}
+}
+
+// Estimate predicts commission of order fully filled in given role. Price is
+// used when order has no price, such as market orders.
+func (fc *FeeCalculator) Estimate(nor NewOrderRequest, role TradeRole, price float64) (*Fee, error) {
+	if fc.Commission == nil {
+		return nil, errors.New("commission rates not set")
+	}
+	if fc.Commission.Symbol != "" && nor.Symbol != fc.Commission.Symbol {
+		return nil, errors.Errorf("commission rates of %s used for %s", fc.Commission.Symbol, nor.Symbol)
+	}
+	if nor.Quantity <= 0 {
+		return nil, errors.Errorf("invalid quantity %v", nor.Quantity)
+	}
+	if nor.Price != 0 {
+		price = nor.Price
+	}
+	if price <= 0 {
+		return nil, errors.New("price required to estimate commission")
+	}
+
+	standard, err := fc.Commission.Standard.rate(nor.Side, role)
+	if err != nil {
+		return nil, err
+	}
+	special, err := fc.Commission.Special.rate(nor.Side, role)
+	if err != nil {
+		return nil, err
+	}
+	tax, err := fc.Commission.Tax.rate(nor.Side, role)
+	if err != nil {
+		return nil, err
+	}
+	other := special + tax
+
+	quoteQty := nor.Quantity * price
+	if d := fc.Commission.Discount; d != nil && d.EnabledForAccount && d.EnabledForSymbol &&
+		d.DiscountAsset != "" && fc.DiscountAssetPrice > 0 {
+		rate := standard*d.Discount + other
+		return &Fee{
+			Asset:  d.DiscountAsset,
+			Amount: quoteQty * rate / fc.DiscountAssetPrice,
+			Rate:   rate,
+		}, nil
+	}
+
+	rate := standard + other
+	if nor.Side == SideBuy {
+		return &Fee{Asset: fc.BaseAsset, Amount: nor.Quantity * rate, Rate: rate}, nil
+	}
+	return &Fee{Asset: fc.QuoteAsset, Amount: quoteQty * rate, Rate: rate}, nil
+}
+
+// rate returns sum of maker or taker rate and buyer or seller rate.
+func (cr CommissionRates) rate(side OrderSide, role TradeRole) (float64, error) {
+	var rate float64
+	switch role {
+	case RoleMaker:
+		rate = cr.Maker
+	case RoleTaker:
+		rate = cr.Taker
+	default:
+		return 0, errors.Errorf("unknown trade role %q", role)
+	}
+	switch side {
+	case SideBuy:
+		rate += cr.Buyer
+	case SideSell:
+		rate += cr.Seller
+	default:
+		return 0, errors.Errorf("unknown order side %q", side)
+	}
+	return rate, nil
+}
@@ -0,0 +1,63 @@
+package binance_test
+
+import (
+	"testing"
+
+	"github.com/binance-exchange/go-binance"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCommission() *binance.AccountCommission {
+	return &binance.AccountCommission{
+		Symbol:   "BNBBTC",
+		Standard: binance.CommissionRates{Maker: 0.001, Taker: 0.002},
+		Tax:      binance.CommissionRates{Taker: 0.0005, Seller: 0.0001},
+		Discount: &binance.CommissionDiscount{
+			EnabledForAccount: true,
+			EnabledForSymbol:  true,
+			DiscountAsset:     "BNB",
+			Discount:          0.75,
+		},
+	}
+}
+
+func TestFeeCalculatorEstimate(t *testing.T) {
+	fc := binance.NewFeeCalculator(testCommission(), "BNB", "BTC")
+
+	buy := binance.NewOrderRequest{Symbol: "BNBBTC", Side: binance.SideBuy, Quantity: 10, Price: 0.01}
+	fee, err := fc.Estimate(buy, binance.RoleMaker, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "BNB", fee.Asset)
+	assert.InDelta(t, 0.01, fee.Amount, 1e-12)
+
+	sell := binance.NewOrderRequest{Symbol: "BNBBTC", Side: binance.SideSell, Type: binance.TypeMarket, Quantity: 10}
+	fee, err = fc.Estimate(sell, binance.RoleTaker, 0.02)
+	assert.Nil(t, err)
+	assert.Equal(t, "BTC", fee.Asset)
+	assert.InDelta(t, 0.0026, fee.Rate, 1e-12)
+	assert.InDelta(t, 0.2*0.0026, fee.Amount, 1e-12)
+
+	_, err = fc.Estimate(sell, binance.RoleTaker, 0)
+	assert.NotNil(t, err)
+	_, err = fc.Estimate(binance.NewOrderRequest{Symbol: "ETHBTC", Side: binance.SideBuy, Quantity: 1, Price: 1}, binance.RoleTaker, 0)
+	assert.NotNil(t, err)
+}
+
+func TestFeeCalculatorDiscount(t *testing.T) {
+	fc := binance.NewFeeCalculator(testCommission(), "ETH", "BTC")
+	fc.Commission.Symbol = "ETHBTC"
+	fc.DiscountAssetPrice = 0.005
+
+	nor := binance.NewOrderRequest{Symbol: "ETHBTC", Side: binance.SideSell, Quantity: 2, Price: 0.05}
+	fee, err := fc.Estimate(nor, binance.RoleTaker, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "BNB", fee.Asset)
+	// standard taker rate discounted, tax rates charged in full
+	assert.InDelta(t, 0.002*0.75+0.0006, fee.Rate, 1e-12)
+	assert.InDelta(t, 0.1*fee.Rate/0.005, fee.Amount, 1e-12)
+
+	fc.Commission.Discount.EnabledForSymbol = false
+	fee, err = fc.Estimate(nor, binance.RoleTaker, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "BTC", fee.Asset)
+}
@@ -28,3 +28,20 @@ var (
 	GTC = TimeInForce("GTC")
 	IOC = TimeInForce("IOC")
 )
+
+// TickerType represents ticker type enum.
+type TickerType string
+
+var (
+	TickerTypeFull = TickerType("FULL")
+	TickerTypeMini = TickerType("MINI")
+)
+
+// SnapshotType represents account snapshot type enum.
+type SnapshotType string
+
+var (
+	SnapshotSpot    = SnapshotType("SPOT")
+	SnapshotMargin  = SnapshotType("MARGIN")
+	SnapshotFutures = SnapshotType("FUTURES")
+)
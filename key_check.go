@@ -0,0 +1,77 @@
+package binance
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const accountStatusNormal = "Normal"
+
+// KeyRequirements lists permissions checked by CheckAPIKey.
+type KeyRequirements struct {
+	SpotAndMarginTrading bool
+	Margin               bool
+	Futures              bool
+	Withdrawals          bool
+	// NoWithdrawals requires withdrawals to be disabled for the key.
+	NoWithdrawals bool
+	IPRestrict    bool
+}
+
+// CheckAPIKey verifies that account status is normal, API trading is not
+// locked and API key has required permissions. It is meant to be called on
+// startup, returned error lists all failed checks.
+func CheckAPIKey(b Binance, kr KeyRequirements) error {
+	status, err := b.AccountStatus(AccountRequest{Timestamp: time.Now()})
+	if err != nil {
+		return errors.Wrap(err, "unable to get account status")
+	}
+	trading, err := b.APITradingStatus(AccountRequest{Timestamp: time.Now()})
+	if err != nil {
+		return errors.Wrap(err, "unable to get API trading status")
+	}
+	perm, err := b.APIKeyPermission(AccountRequest{Timestamp: time.Now()})
+	if err != nil {
+		return errors.Wrap(err, "unable to get API key permissions")
+	}
+
+	var problems []string
+	if status.Status != accountStatusNormal {
+		problems = append(problems, "account status "+status.Status)
+	}
+	if trading.IsLocked {
+		p := "API trading locked"
+		if !trading.PlannedRecoverTime.IsZero() {
+			p += " until " + trading.PlannedRecoverTime.UTC().Format(time.RFC3339)
+		}
+		problems = append(problems, p)
+	}
+	if kr.SpotAndMarginTrading && !perm.EnableSpotAndMarginTrading {
+		problems = append(problems, "spot and margin trading not enabled")
+	}
+	if kr.SpotAndMarginTrading && !perm.TradingAuthorityExpirationTime.IsZero() &&
+		perm.TradingAuthorityExpirationTime.Before(time.Now()) {
+		problems = append(problems, "trading authority expired")
+	}
+	if kr.Margin && !perm.EnableMargin {
+		problems = append(problems, "margin not enabled")
+	}
+	if kr.Futures && !perm.EnableFutures {
+		problems = append(problems, "futures not enabled")
+	}
+	if kr.Withdrawals && !perm.EnableWithdrawals {
+		problems = append(problems, "withdrawals not enabled")
+	}
+	if kr.NoWithdrawals && perm.EnableWithdrawals {
+		problems = append(problems, "withdrawals enabled")
+	}
+	if kr.IPRestrict && !perm.IPRestrict {
+		problems = append(problems, "key not restricted to IP addresses")
+	}
+	if len(problems) > 0 {
+		return errors.New("API key check failed: " + strings.Join(problems, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package binance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/binance-exchange/go-binance"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func mockKeyStatus(binanceService *ServiceMock, status string, locked bool, perm *binance.APIKeyPermission) {
+	anyRequest := mock.AnythingOfType("binance.AccountRequest")
+	binanceService.On("AccountStatus", anyRequest).Return(&binance.AccountStatus{Status: status}, nil).Once()
+	binanceService.On("APITradingStatus", anyRequest).Return(&binance.APITradingStatus{IsLocked: locked}, nil).Once()
+	binanceService.On("APIKeyPermission", anyRequest).Return(perm, nil).Once()
+}
+
+func TestCheckAPIKey(t *testing.T) {
+	binanceService := &ServiceMock{}
+	b := binance.NewBinance(binanceService)
+	kr := binance.KeyRequirements{SpotAndMarginTrading: true, NoWithdrawals: true, IPRestrict: true}
+
+	mockKeyStatus(binanceService, "Normal", false, &binance.APIKeyPermission{
+		IPRestrict:                 true,
+		EnableReading:              true,
+		EnableSpotAndMarginTrading: true,
+	})
+	assert.Nil(t, binance.CheckAPIKey(b, kr))
+
+	mockKeyStatus(binanceService, "Normal", true, &binance.APIKeyPermission{
+		EnableSpotAndMarginTrading:     true,
+		EnableWithdrawals:              true,
+		TradingAuthorityExpirationTime: time.Now().Add(-time.Hour),
+	})
+	err := binance.CheckAPIKey(b, kr)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "API key check failed: API trading locked, trading authority expired, "+
+			"withdrawals enabled, key not restricted to IP addresses", err.Error())
+	}
+	binanceService.AssertExpectations(t)
+}
@@ -0,0 +1,575 @@
+package binance
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+const (
+	defaultLiquidationLevel = 1.1
+	defaultRiskPollInterval = time.Minute
+	defaultReconnectDelay   = time.Second
+	maxReconnectDelay       = time.Minute
+	crossMarginAccount      = "CROSS"
+	crossValuationAsset     = "BTC"
+)
+
+// MarginRisk represents computed risk of cross margin account or isolated pair.
+//
+// Account is "CROSS" for cross margin account and symbol of isolated pair
+// otherwise. LiquidationPrice of isolated pair is price of base asset in quote
+// asset at which margin level reaches liquidation level. Cross margin account
+// has no single price, its LiquidationPrice is price of BTC relative to the
+// current one, e.g. 0.8 when liquidation is reached after BTC falls by 20%
+// against all other assets. LiquidationPrice is zero when it cannot be
+// reached, e.g. when nothing is borrowed.
+type MarginRisk struct {
+	Account          string
+	Isolated         bool
+	MarginLevel      float64
+	Price            float64
+	LiquidationPrice float64
+	Time             time.Time
+}
+
+// MarginWarning is emitted when margin level falls below Threshold.
+type MarginWarning struct {
+	MarginRisk
+	Threshold float64
+}
+
+// MarginRiskMonitor watches margin level of cross margin account and
+// isolated pairs.
+//
+// Positions are refreshed from MarginAccount and IsolatedMarginAccount every
+// PollInterval. Between refreshes, margin levels are recomputed on each price
+// passed to UpdatePrice, which is fed by trade streams when Run is called with
+// LiveStreams set. Cross margin positions are valued in BTC using live prices
+// or margin price index of <ASSET>BTC (or inverted BTC<ASSET>) pairs.
+//
+// OnWarning is called once each time margin level drops below one of
+// Thresholds, and again only after the level recovers above it. DeRisk is
+// called the same way when margin level drops below DeRiskLevel. Callbacks are
+// called one at a time in the order of evaluations and must not call Refresh
+// or UpdatePrice.
+//
+// Closed trade streams are reconnected with exponential backoff starting at
+// ReconnectDelay, OnStreamStopped is called each time a stream stops.
+type MarginRiskMonitor struct {
+	Binance         Binance
+	Cross           bool
+	IsolatedSymbols []string
+	// Thresholds are margin levels emitting warnings, 1.5 and 1.3 by default.
+	Thresholds []float64
+	// LiquidationLevel is margin level of liquidation, 1.1 by default.
+	LiquidationLevel float64
+	OnWarning        func(*MarginWarning)
+	// DeRiskLevel enables DeRisk callback when non-zero.
+	DeRiskLevel float64
+	DeRisk      func(*MarginRisk) error
+	// PollInterval is the period of account refresh, 1m by default.
+	PollInterval time.Duration
+	// LiveStreams subscribes trade streams of monitored symbols in Run.
+	LiveStreams bool
+	// ReconnectDelay is the initial delay of stream reconnection, 1s by
+	// default, doubled after each failed attempt up to 1m.
+	ReconnectDelay time.Duration
+	// OnStreamStopped is called with symbol when its trade stream closes,
+	// and with error when stream cannot be subscribed or reconnected.
+	OnStreamStopped func(symbol string, err error)
+	Logger          log.Logger
+
+	// dispatchMu serializes evaluations with dispatch of their events.
+	dispatchMu sync.Mutex
+	mu         sync.Mutex
+	cross      *MarginAccount
+	isolated   map[string]*IsolatedMarginPair
+	prices     map[string]float64
+	risks      map[string]*MarginRisk
+	breached   map[string]map[float64]bool
+	deRisked   map[string]bool
+}
+
+// NewMarginRiskMonitor returns MarginRiskMonitor of cross margin account and
+// given isolated pairs with default thresholds.
+func NewMarginRiskMonitor(b Binance, isolatedSymbols []string, logger log.Logger) *MarginRiskMonitor {
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	return &MarginRiskMonitor{
+		Binance:          b,
+		Cross:            true,
+		IsolatedSymbols:  isolatedSymbols,
+		Thresholds:       []float64{1.5, 1.3},
+		LiquidationLevel: defaultLiquidationLevel,
+		PollInterval:     defaultRiskPollInterval,
+		Logger:           logger,
+	}
+}
+
+// Run refreshes positions every PollInterval until ctx is done. With
+// LiveStreams set, trade streams are subscribed for symbols found by each
+// refresh. All streams are closed before Run returns.
+func (m *MarginRiskMonitor) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	if err := m.Refresh(); err != nil {
+		return err
+	}
+	watched := make(map[string]bool)
+	if m.LiveStreams {
+		if err := m.watchNew(ctx, &wg, watched); err != nil {
+			return err
+		}
+	}
+	interval := m.PollInterval
+	if interval <= 0 {
+		interval = defaultRiskPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := m.Refresh(); err != nil {
+				level.Error(m.logger()).Log("msg", "margin risk refresh failed", "err", err)
+				continue
+			}
+			if !m.LiveStreams {
+				continue
+			}
+			if err := m.watchNew(ctx, &wg, watched); err != nil {
+				// subscription is retried on next refresh
+				level.Error(m.logger()).Log("msg", "trade stream subscription failed", "err", err)
+			}
+		}
+	}
+}
+
+// Refresh reloads positions and price indexes and evaluates margin levels.
+func (m *MarginRiskMonitor) Refresh() error {
+	var cross *MarginAccount
+	prices := make(map[string]float64)
+	if m.Cross {
+		var err error
+		cross, err = m.Binance.MarginAccount(AccountRequest{Timestamp: time.Now()})
+		if err != nil {
+			return err
+		}
+		for _, a := range cross.Assets {
+			if a.Asset == crossValuationAsset || a.Free+a.Locked == 0 && a.Borrowed+a.Interest == 0 {
+				continue
+			}
+			for _, symbol := range []string{a.Asset + crossValuationAsset, crossValuationAsset + a.Asset} {
+				mpi, err := m.Binance.MarginPriceIndex(MarginPriceIndexRequest{Symbol: symbol})
+				if err == nil {
+					prices[symbol] = mpi.Price
+					break
+				}
+			}
+		}
+	}
+	isolated := make(map[string]*IsolatedMarginPair)
+	if len(m.IsolatedSymbols) > 0 {
+		// API accepts up to 5 symbols per request
+		for i := 0; i < len(m.IsolatedSymbols); i += 5 {
+			j := i + 5
+			if j > len(m.IsolatedSymbols) {
+				j = len(m.IsolatedSymbols)
+			}
+			acc, err := m.Binance.IsolatedMarginAccount(IsolatedMarginAccountRequest{
+				Symbols:   m.IsolatedSymbols[i:j],
+				Timestamp: time.Now(),
+			})
+			if err != nil {
+				return err
+			}
+			for _, p := range acc.Pairs {
+				isolated[p.Symbol] = p
+				prices[p.Symbol] = p.IndexPrice
+			}
+		}
+	}
+
+	m.dispatchMu.Lock()
+	defer m.dispatchMu.Unlock()
+	m.mu.Lock()
+	m.cross = cross
+	m.isolated = isolated
+	m.prices = prices
+	events := m.evaluate()
+	m.mu.Unlock()
+	m.dispatch(events)
+	return nil
+}
+
+// UpdatePrice sets last price of symbol and reevaluates margin levels.
+func (m *MarginRiskMonitor) UpdatePrice(symbol string, price float64) {
+	m.dispatchMu.Lock()
+	defer m.dispatchMu.Unlock()
+	m.mu.Lock()
+	if m.prices == nil {
+		m.prices = make(map[string]float64)
+	}
+	m.prices[symbol] = price
+	events := m.evaluate()
+	m.mu.Unlock()
+	m.dispatch(events)
+}
+
+// Risks returns last computed risks of all monitored accounts.
+func (m *MarginRiskMonitor) Risks() []*MarginRisk {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var mrc []*MarginRisk
+	if r, ok := m.risks[crossMarginAccount]; ok {
+		c := *r
+		mrc = append(mrc, &c)
+	}
+	for _, symbol := range m.IsolatedSymbols {
+		if r, ok := m.risks[symbol]; ok {
+			c := *r
+			mrc = append(mrc, &c)
+		}
+	}
+	return mrc
+}
+
+type marginRiskEvent struct {
+	warning *MarginWarning
+	deRisk  *MarginRisk
+}
+
+// evaluate recomputes risks and returns events to dispatch, caller must hold
+// m.mu.
+func (m *MarginRiskMonitor) evaluate() []marginRiskEvent {
+	now := time.Now()
+	risks := make(map[string]*MarginRisk)
+	if m.cross != nil {
+		risks[crossMarginAccount] = &MarginRisk{
+			Account:          crossMarginAccount,
+			MarginLevel:      m.crossMarginLevel(),
+			LiquidationPrice: m.crossLiquidationPrice(),
+			Time:             now,
+		}
+	}
+	for symbol, p := range m.isolated {
+		price := m.prices[symbol]
+		risks[symbol] = &MarginRisk{
+			Account:          symbol,
+			Isolated:         true,
+			MarginLevel:      isolatedMarginLevel(p, price),
+			Price:            price,
+			LiquidationPrice: isolatedLiquidationPrice(p, m.liquidationLevel()),
+			Time:             now,
+		}
+	}
+	m.risks = risks
+
+	if m.breached == nil {
+		m.breached = make(map[string]map[float64]bool)
+		m.deRisked = make(map[string]bool)
+	}
+	var events []marginRiskEvent
+	for account, r := range risks {
+		breached := m.breached[account]
+		if breached == nil {
+			breached = make(map[float64]bool)
+			m.breached[account] = breached
+		}
+		for _, t := range m.Thresholds {
+			if crossedBelow(breached, t, r.MarginLevel) {
+				c := *r
+				events = append(events, marginRiskEvent{warning: &MarginWarning{MarginRisk: c, Threshold: t}})
+			}
+		}
+		if m.DeRiskLevel == 0 {
+			continue
+		}
+		if r.MarginLevel >= m.DeRiskLevel {
+			delete(m.deRisked, account)
+		} else if !m.deRisked[account] {
+			m.deRisked[account] = true
+			c := *r
+			events = append(events, marginRiskEvent{deRisk: &c})
+		}
+	}
+	return events
+}
+
+// crossedBelow reports whether level dropped below threshold since the last
+// call and updates breached state.
+func crossedBelow(breached map[float64]bool, threshold, level float64) bool {
+	if level >= threshold {
+		delete(breached, threshold)
+		return false
+	}
+	if breached[threshold] {
+		return false
+	}
+	breached[threshold] = true
+	return true
+}
+
+func (m *MarginRiskMonitor) dispatch(events []marginRiskEvent) {
+	for _, e := range events {
+		if e.warning != nil {
+			level.Warn(m.logger()).Log("msg", "margin level below threshold", "account", e.warning.Account,
+				"marginLevel", e.warning.MarginLevel, "threshold", e.warning.Threshold,
+				"liquidationPrice", e.warning.LiquidationPrice)
+			if m.OnWarning != nil {
+				m.OnWarning(e.warning)
+			}
+		}
+		if e.deRisk != nil && m.DeRisk != nil {
+			if err := m.DeRisk(e.deRisk); err != nil {
+				level.Error(m.logger()).Log("msg", "de-risking failed", "account", e.deRisk.Account, "err", err)
+			}
+		}
+	}
+}
+
+// crossMarginLevel values cross margin positions in BTC using known prices.
+// Margin level reported by account is used when any asset cannot be valued.
+func (m *MarginRiskMonitor) crossMarginLevel() float64 {
+	var assets, liabilities float64
+	for _, a := range m.cross.Assets {
+		total := a.Free + a.Locked
+		liability := a.Borrowed + a.Interest
+		if total == 0 && liability == 0 {
+			continue
+		}
+		price, ok := m.valuationPrice(a.Asset)
+		if !ok {
+			return m.cross.MarginLevel
+		}
+		assets += total * price
+		liabilities += liability * price
+	}
+	if liabilities == 0 {
+		return math.Inf(1)
+	}
+	return assets / liabilities
+}
+
+// crossLiquidationPrice solves margin level equation of cross margin account
+// for price of BTC relative to the current one, with prices of other assets
+// in any common quote asset unchanged.
+func (m *MarginRiskMonitor) crossLiquidationPrice() float64 {
+	// positions in BTC and positions of other assets valued in BTC, the latter
+	// scale by 1/k when BTC price changes by factor k
+	var btcAsset, btcLiability, otherAsset, otherLiability float64
+	for _, a := range m.cross.Assets {
+		total := a.Free + a.Locked
+		liability := a.Borrowed + a.Interest
+		if total == 0 && liability == 0 {
+			continue
+		}
+		if a.Asset == crossValuationAsset {
+			btcAsset += total
+			btcLiability += liability
+			continue
+		}
+		price, ok := m.valuationPrice(a.Asset)
+		if !ok {
+			return 0
+		}
+		otherAsset += total * price
+		otherLiability += liability * price
+	}
+
+	// btcAsset + otherAsset/k = liquidationLevel * (btcLiability + otherLiability/k)
+	liquidationLevel := m.liquidationLevel()
+	d := liquidationLevel*btcLiability - btcAsset
+	if d == 0 {
+		return 0
+	}
+	k := (otherAsset - liquidationLevel*otherLiability) / d
+	if k <= 0 {
+		return 0
+	}
+	return k
+}
+
+func (m *MarginRiskMonitor) valuationPrice(asset string) (float64, bool) {
+	if asset == crossValuationAsset {
+		return 1, true
+	}
+	if p, ok := m.prices[asset+crossValuationAsset]; ok && p > 0 {
+		return p, true
+	}
+	if p, ok := m.prices[crossValuationAsset+asset]; ok && p > 0 {
+		return 1 / p, true
+	}
+	return 0, false
+}
+
+func (m *MarginRiskMonitor) liquidationLevel() float64 {
+	if m.LiquidationLevel <= 0 {
+		return defaultLiquidationLevel
+	}
+	return m.LiquidationLevel
+}
+
+// symbols returns symbols of prices used by monitor.
+func (m *MarginRiskMonitor) symbols() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var symbols []string
+	for symbol := range m.prices {
+		symbols = append(symbols, symbol)
+	}
+	return symbols
+}
+
+// watchNew subscribes trade streams of symbols not watched yet. Streams
+// are closed when ctx is done.
+func (m *MarginRiskMonitor) watchNew(ctx context.Context, wg *sync.WaitGroup, watched map[string]bool) error {
+	for _, symbol := range m.symbols() {
+		if watched[symbol] {
+			continue
+		}
+		if err := m.watch(ctx, wg, symbol); err != nil {
+			m.streamStopped(symbol, err)
+			return err
+		}
+		watched[symbol] = true
+	}
+	return nil
+}
+
+// watch subscribes trade stream of symbol and feeds its prices to
+// UpdatePrice in order until ctx is done, reconnecting closed stream.
+func (m *MarginRiskMonitor) watch(ctx context.Context, wg *sync.WaitGroup, symbol string) error {
+	ech, done, err := m.Binance.TradeWebsocket(TradeWebsocketRequest{Symbol: symbol, Ctx: ctx})
+	if err != nil {
+		return err
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			m.consume(ctx, symbol, ech, done)
+			if ctx.Err() != nil {
+				return
+			}
+			level.Warn(m.logger()).Log("msg", "trade stream closed", "symbol", symbol)
+			m.streamStopped(symbol, nil)
+			ech, done = m.reconnect(ctx, symbol)
+			if ech == nil {
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// consume updates prices from events of stream until it closes or ctx is
+// done.
+func (m *MarginRiskMonitor) consume(ctx context.Context, symbol string, ech chan *AggTradeEvent, done chan struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-done:
+			return
+		case e, ok := <-ech:
+			if !ok {
+				return
+			}
+			if e != nil {
+				m.UpdatePrice(symbol, e.Price)
+			}
+		}
+	}
+}
+
+// reconnect subscribes trade stream of symbol with exponential backoff, it
+// returns nil channels when ctx is done first.
+func (m *MarginRiskMonitor) reconnect(ctx context.Context, symbol string) (chan *AggTradeEvent, chan struct{}) {
+	delay := m.ReconnectDelay
+	if delay <= 0 {
+		delay = defaultReconnectDelay
+	}
+	for {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, nil
+		case <-timer.C:
+		}
+		ech, done, err := m.Binance.TradeWebsocket(TradeWebsocketRequest{Symbol: symbol, Ctx: ctx})
+		if err == nil {
+			level.Info(m.logger()).Log("msg", "trade stream reconnected", "symbol", symbol)
+			return ech, done
+		}
+		if delay *= 2; delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+		level.Error(m.logger()).Log("msg", "trade stream reconnection failed", "symbol", symbol,
+			"retryIn", delay, "err", err)
+		m.streamStopped(symbol, err)
+	}
+}
+
+func (m *MarginRiskMonitor) streamStopped(symbol string, err error) {
+	if m.OnStreamStopped != nil {
+		m.OnStreamStopped(symbol, err)
+	}
+}
+
+func (m *MarginRiskMonitor) logger() log.Logger {
+	if m.Logger == nil {
+		return log.NewNopLogger()
+	}
+	return m.Logger
+}
+
+// isolatedMarginLevel computes margin level of pair at price of base asset in
+// quote asset, index price is used when price is zero.
+func isolatedMarginLevel(p *IsolatedMarginPair, price float64) float64 {
+	if price <= 0 {
+		price = p.IndexPrice
+	}
+	if price <= 0 {
+		return p.MarginLevel
+	}
+	assets := (p.Base.Free+p.Base.Locked)*price + p.Quote.Free + p.Quote.Locked
+	liabilities := (p.Base.Borrowed+p.Base.Interest)*price + p.Quote.Borrowed + p.Quote.Interest
+	if liabilities == 0 {
+		return math.Inf(1)
+	}
+	return assets / liabilities
+}
+
+// isolatedLiquidationPrice solves margin level equation of pair for price of
+// base asset at which margin level reaches liquidation level.
+func isolatedLiquidationPrice(p *IsolatedMarginPair, liquidationLevel float64) float64 {
+	baseAsset := p.Base.Free + p.Base.Locked
+	baseLiability := p.Base.Borrowed + p.Base.Interest
+	quoteAsset := p.Quote.Free + p.Quote.Locked
+	quoteLiability := p.Quote.Borrowed + p.Quote.Interest
+
+	d := baseAsset - liquidationLevel*baseLiability
+	if d == 0 {
+		return 0
+	}
+	price := (liquidationLevel*quoteLiability - quoteAsset) / d
+	if price <= 0 {
+		return 0
+	}
+	return price
+}
@@ -0,0 +1,232 @@
+package binance_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/binance-exchange/go-binance"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMarginRiskMonitorIsolated(t *testing.T) {
+	binanceService := &ServiceMock{}
+	m := binance.NewMarginRiskMonitor(binance.NewBinance(binanceService), []string{"BTCUSDT"}, nil)
+	m.Cross = false
+	m.DeRiskLevel = 1.25
+
+	var warnings []float64
+	m.OnWarning = func(w *binance.MarginWarning) {
+		warnings = append(warnings, w.Threshold)
+	}
+	var deRisked []*binance.MarginRisk
+	m.DeRisk = func(r *binance.MarginRisk) error {
+		deRisked = append(deRisked, r)
+		return nil
+	}
+
+	binanceService.On("IsolatedMarginAccount", mock.AnythingOfType("binance.IsolatedMarginAccountRequest")).
+		Return(&binance.IsolatedMarginAccount{
+			Pairs: []*binance.IsolatedMarginPair{{
+				Symbol:     "BTCUSDT",
+				Base:       &binance.IsolatedMarginAsset{Asset: "BTC", Free: 1},
+				Quote:      &binance.IsolatedMarginAsset{Asset: "USDT", Borrowed: 5000},
+				IndexPrice: 10000,
+			}},
+		}, nil).Once()
+	assert.Nil(t, m.Refresh())
+
+	risks := m.Risks()
+	assert.Len(t, risks, 1)
+	assert.InDelta(t, 2, risks[0].MarginLevel, 1e-9)
+	assert.InDelta(t, 5500, risks[0].LiquidationPrice, 1e-9)
+	assert.Empty(t, warnings)
+
+	m.UpdatePrice("BTCUSDT", 7000)
+	assert.Equal(t, []float64{1.5}, warnings)
+	m.UpdatePrice("BTCUSDT", 6000)
+	assert.Equal(t, []float64{1.5, 1.3}, warnings)
+	assert.Len(t, deRisked, 1)
+	assert.InDelta(t, 1.2, deRisked[0].MarginLevel, 1e-9)
+
+	m.UpdatePrice("BTCUSDT", 6100)
+	assert.Equal(t, []float64{1.5, 1.3}, warnings)
+	assert.Len(t, deRisked, 1)
+
+	m.UpdatePrice("BTCUSDT", 10000)
+	m.UpdatePrice("BTCUSDT", 7000)
+	assert.Equal(t, []float64{1.5, 1.3, 1.5}, warnings)
+	binanceService.AssertExpectations(t)
+}
+
+func TestMarginRiskMonitorCross(t *testing.T) {
+	binanceService := &ServiceMock{}
+	m := binance.NewMarginRiskMonitor(binance.NewBinance(binanceService), nil, nil)
+
+	binanceService.On("MarginAccount", mock.AnythingOfType("binance.AccountRequest")).
+		Return(&binance.MarginAccount{
+			MarginLevel: 999,
+			Assets: []*binance.Asset{
+				{Asset: "BTC", Free: 1},
+				{Asset: "USDT", Borrowed: 4900, Interest: 100},
+				{Asset: "BNB"},
+			},
+		}, nil).Once()
+	binanceService.On("MarginPriceIndex", binance.MarginPriceIndexRequest{Symbol: "USDTBTC"}).
+		Return(nil, errors.New("invalid symbol")).Once()
+	binanceService.On("MarginPriceIndex", binance.MarginPriceIndexRequest{Symbol: "BTCUSDT"}).
+		Return(&binance.MarginPriceIndex{Symbol: "BTCUSDT", Price: 10000}, nil).Once()
+	assert.Nil(t, m.Refresh())
+
+	risks := m.Risks()
+	assert.Len(t, risks, 1)
+	assert.Equal(t, "CROSS", risks[0].Account)
+	assert.InDelta(t, 2, risks[0].MarginLevel, 1e-9)
+
+	// liquidation level 1.1 is reached when BTC falls to 0.55 of its price
+	assert.InDelta(t, 0.55, risks[0].LiquidationPrice, 1e-9)
+
+	m.UpdatePrice("BTCUSDT", 5000)
+	assert.InDelta(t, 1, m.Risks()[0].MarginLevel, 1e-9)
+	binanceService.AssertExpectations(t)
+}
+
+func TestMarginRiskMonitorReconnectsStream(t *testing.T) {
+	binanceService := &ServiceMock{}
+	m := binance.NewMarginRiskMonitor(binance.NewBinance(binanceService), []string{"BTCUSDT"}, nil)
+	m.Cross = false
+	m.LiveStreams = true
+	m.PollInterval = time.Hour
+	m.ReconnectDelay = time.Millisecond
+
+	warnings := make(chan float64, 10)
+	m.OnWarning = func(w *binance.MarginWarning) {
+		warnings <- w.Threshold
+	}
+	stopped := make(chan error, 10)
+	m.OnStreamStopped = func(symbol string, err error) {
+		assert.Equal(t, "BTCUSDT", symbol)
+		stopped <- err
+	}
+
+	binanceService.On("IsolatedMarginAccount", mock.AnythingOfType("binance.IsolatedMarginAccountRequest")).
+		Return(&binance.IsolatedMarginAccount{
+			Pairs: []*binance.IsolatedMarginPair{{
+				Symbol:     "BTCUSDT",
+				Base:       &binance.IsolatedMarginAsset{Asset: "BTC", Free: 1},
+				Quote:      &binance.IsolatedMarginAsset{Asset: "USDT", Borrowed: 5000},
+				IndexPrice: 10000,
+			}},
+		}, nil).Once()
+	ech1, done1 := make(chan *binance.AggTradeEvent), make(chan struct{})
+	ech2, done2 := make(chan *binance.AggTradeEvent), make(chan struct{})
+	twr := tradeWebsocketRequest("BTCUSDT")
+	binanceService.On("TradeWebsocket", twr).Return(ech1, done1, nil).Once()
+	binanceService.On("TradeWebsocket", twr).Return(nil, nil, errors.New("dial failed")).Once()
+	binanceService.On("TradeWebsocket", twr).Return(ech2, done2, nil).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go m.Run(ctx)
+
+	ech1 <- &binance.AggTradeEvent{AggTrade: binance.AggTrade{Price: 7000}}
+	assert.Equal(t, 1.5, <-warnings)
+	close(done1)
+	assert.Nil(t, <-stopped)
+	assert.EqualError(t, <-stopped, "dial failed")
+
+	ech2 <- &binance.AggTradeEvent{AggTrade: binance.AggTrade{Price: 6000}}
+	assert.Equal(t, 1.3, <-warnings)
+	binanceService.AssertExpectations(t)
+}
+
+// tradeWebsocketRequest matches TradeWebsocketRequest of symbol with any
+// context.
+func tradeWebsocketRequest(symbol string) interface{} {
+	return mock.MatchedBy(func(twr binance.TradeWebsocketRequest) bool {
+		return twr.Symbol == symbol
+	})
+}
+
+func isolatedMarginAccount(symbols ...string) *binance.IsolatedMarginAccount {
+	acc := &binance.IsolatedMarginAccount{}
+	for _, symbol := range symbols {
+		acc.Pairs = append(acc.Pairs, &binance.IsolatedMarginPair{
+			Symbol:     symbol,
+			Base:       &binance.IsolatedMarginAsset{Free: 1},
+			Quote:      &binance.IsolatedMarginAsset{Borrowed: 100},
+			IndexPrice: 1000,
+		})
+	}
+	return acc
+}
+
+func TestMarginRiskMonitorWatchesNewSymbols(t *testing.T) {
+	binanceService := &ServiceMock{}
+	m := binance.NewMarginRiskMonitor(binance.NewBinance(binanceService), []string{"BTCUSDT", "ETHUSDT"}, nil)
+	m.Cross = false
+	m.LiveStreams = true
+	m.PollInterval = time.Millisecond
+
+	binanceService.On("IsolatedMarginAccount", mock.AnythingOfType("binance.IsolatedMarginAccountRequest")).
+		Return(isolatedMarginAccount("BTCUSDT"), nil).Once()
+	binanceService.On("IsolatedMarginAccount", mock.AnythingOfType("binance.IsolatedMarginAccountRequest")).
+		Return(isolatedMarginAccount("BTCUSDT", "ETHUSDT"), nil)
+	streams := make(chan binance.TradeWebsocketRequest, 10)
+	for _, symbol := range []string{"BTCUSDT", "ETHUSDT"} {
+		binanceService.On("TradeWebsocket", tradeWebsocketRequest(symbol)).
+			Run(func(args mock.Arguments) {
+				streams <- args.Get(0).(binance.TradeWebsocketRequest)
+			}).
+			Return(make(chan *binance.AggTradeEvent), make(chan struct{}), nil).Once()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error)
+	go func() {
+		stopped <- m.Run(ctx)
+	}()
+	btc, eth := <-streams, <-streams
+	assert.Equal(t, "BTCUSDT", btc.Symbol)
+	assert.Equal(t, "ETHUSDT", eth.Symbol)
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-stopped)
+	for _, twr := range []binance.TradeWebsocketRequest{btc, eth} {
+		assert.NotNil(t, twr.Ctx.Err(), "stream of %s not closed", twr.Symbol)
+	}
+	binanceService.AssertExpectations(t)
+}
+
+func TestMarginRiskMonitorClosesStreamsOnError(t *testing.T) {
+	binanceService := &ServiceMock{}
+	m := binance.NewMarginRiskMonitor(binance.NewBinance(binanceService), []string{"BTCUSDT", "ETHUSDT"}, nil)
+	m.Cross = false
+	m.LiveStreams = true
+	var failed []string
+	m.OnStreamStopped = func(symbol string, err error) {
+		assert.NotNil(t, err)
+		failed = append(failed, symbol)
+	}
+
+	binanceService.On("IsolatedMarginAccount", mock.AnythingOfType("binance.IsolatedMarginAccountRequest")).
+		Return(isolatedMarginAccount("BTCUSDT", "ETHUSDT"), nil).Once()
+	var subscribed []binance.TradeWebsocketRequest
+	binanceService.On("TradeWebsocket", tradeWebsocketRequest("BTCUSDT")).
+		Run(func(args mock.Arguments) {
+			subscribed = append(subscribed, args.Get(0).(binance.TradeWebsocketRequest))
+		}).
+		Return(make(chan *binance.AggTradeEvent), make(chan struct{}), nil)
+	binanceService.On("TradeWebsocket", tradeWebsocketRequest("ETHUSDT")).
+		Return(nil, nil, errors.New("dial failed")).Once()
+
+	err := m.Run(context.Background())
+	assert.EqualError(t, err, "dial failed")
+	assert.Equal(t, []string{"ETHUSDT"}, failed)
+	// symbols are subscribed in any order, BTCUSDT stream is closed if opened
+	for _, twr := range subscribed {
+		assert.NotNil(t, twr.Ctx.Err())
+	}
+}
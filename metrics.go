@@ -0,0 +1,159 @@
+package binance
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+)
+
+// Label names of Metrics, metric vectors of backend (e.g. Prometheus
+// CounterVec) must be created with these labels.
+var (
+	RequestLabels = []string{"endpoint", "method", "status"}
+	ErrorLabels   = []string{"endpoint", "code"}
+	WeightLabels  = []string{"api"}
+	StreamLabels  = []string{"stream"}
+)
+
+// Metrics collects REST and websocket activity of Service.
+//
+// NewMetrics of prometheus subpackage returns Metrics registered in Prometheus
+// registry. Metrics are go-kit metrics, so that other backends supported by go-kit can
+// be used as well. Any of metrics may be nil. Use Middleware to pass Metrics
+// to NewAPIService.
+type Metrics struct {
+	// Requests counts REST calls by RequestLabels. Status is "error" when no
+	// response was received.
+	Requests metrics.Counter
+	// RequestDuration observes latency of REST calls in seconds by
+	// RequestLabels.
+	RequestDuration metrics.Histogram
+	// Errors counts error codes returned by exchange by ErrorLabels.
+	Errors metrics.Counter
+	// UsedWeight is request weight used in the current minute by WeightLabels,
+	// api is either api or sapi.
+	UsedWeight metrics.Gauge
+	// WeightLimit is request weight limit per minute by WeightLabels. It is
+	// not populated automatically, limit must be set by SetWeightLimit.
+	WeightLimit metrics.Gauge
+
+	// WSConnected is number of open connections by StreamLabels.
+	WSConnected metrics.Gauge
+	// WSReconnects counts connections of stream that was connected before by
+	// StreamLabels.
+	WSReconnects metrics.Counter
+	// WSMessages counts received messages by StreamLabels.
+	WSMessages metrics.Counter
+	// WSLag observes time between event time and time the message was
+	// received in seconds by StreamLabels.
+	WSLag metrics.Histogram
+
+	mu      sync.Mutex
+	streams map[string]bool
+}
+
+// Middleware returns Middleware which records metrics.
+func (m *Metrics) Middleware() Middleware {
+	return Middleware{
+		Call:    m.call,
+		WS:      m.ws,
+		WSState: m.wsState,
+	}
+}
+
+// SetWeightLimit sets request weight limit per minute of api, either api or
+// sapi. Limit is set manually, it is the REQUEST_WEIGHT limit listed in
+// rateLimits of exchange info (6000 for api at the time of writing).
+func (m *Metrics) SetWeightLimit(api string, limit float64) {
+	if m.WeightLimit != nil {
+		m.WeightLimit.With("api", api).Set(limit)
+	}
+}
+
+func (m *Metrics) call(next CallHandler) CallHandler {
+	return func(call *Call) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next(call)
+		status := "error"
+		if err == nil {
+			status = strconv.Itoa(resp.StatusCode)
+		}
+		if m.Requests != nil {
+			m.Requests.With("endpoint", call.Endpoint, "method", call.Method, "status", status).Add(1)
+		}
+		if m.RequestDuration != nil {
+			m.RequestDuration.With("endpoint", call.Endpoint, "method", call.Method, "status", status).
+				Observe(time.Since(start).Seconds())
+		}
+		if err != nil {
+			return nil, err
+		}
+		if m.UsedWeight != nil {
+			if weight, err := strconv.ParseFloat(usedWeight(resp.Header), 64); err == nil {
+				m.UsedWeight.With("api", apiName(call.Endpoint)).Set(weight)
+			}
+		}
+		if m.Errors != nil && resp.StatusCode != 200 {
+			if code, ok := peekErrorCode(resp); ok {
+				m.Errors.With("endpoint", call.Endpoint, "code", strconv.Itoa(code)).Add(1)
+			}
+		}
+		return resp, nil
+	}
+}
+
+func (m *Metrics) ws(next WSHandler) WSHandler {
+	return func(msg *WSMessage) error {
+		if m.WSMessages != nil {
+			m.WSMessages.With("stream", msg.Stream).Add(1)
+		}
+		if m.WSLag != nil {
+			// e is declared so that it is not matched to E case-insensitively
+			event := struct {
+				Type string `json:"e"`
+				Time int64  `json:"E"`
+			}{}
+			if err := json.Unmarshal(msg.Data, &event); err == nil && event.Time > 0 {
+				lag := msg.Received.Sub(time.Unix(0, event.Time*int64(time.Millisecond)))
+				m.WSLag.With("stream", msg.Stream).Observe(lag.Seconds())
+			}
+		}
+		return next(msg)
+	}
+}
+
+func (m *Metrics) wsState(stream string, connected bool) {
+	if !connected {
+		if m.WSConnected != nil {
+			m.WSConnected.With("stream", stream).Add(-1)
+		}
+		return
+	}
+	m.mu.Lock()
+	if m.streams == nil {
+		m.streams = make(map[string]bool)
+	}
+	reconnect := m.streams[stream]
+	m.streams[stream] = true
+	m.mu.Unlock()
+
+	if m.WSConnected != nil {
+		m.WSConnected.With("stream", stream).Add(1)
+	}
+	if reconnect && m.WSReconnects != nil {
+		m.WSReconnects.With("stream", stream).Add(1)
+	}
+}
+
+// apiName returns api or sapi, which have separate weight limits.
+func apiName(endpoint string) string {
+	if i := strings.IndexByte(endpoint, '/'); i >= 0 {
+		return endpoint[:i]
+	}
+	return endpoint
+}
@@ -0,0 +1,161 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/gorilla/websocket"
+)
+
+// testMetric records values by label values, Add sums, Set and Observe
+// overwrite.
+type testMetric struct {
+	mu     *sync.Mutex
+	values map[string]float64
+	lvs    []string
+}
+
+func newTestMetric() *testMetric {
+	return &testMetric{mu: &sync.Mutex{}, values: make(map[string]float64)}
+}
+
+func (tm *testMetric) with(lvs []string) *testMetric {
+	return &testMetric{mu: tm.mu, values: tm.values, lvs: append(append([]string{}, tm.lvs...), lvs...)}
+}
+
+func (tm *testMetric) get(lvs ...string) float64 {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	return tm.values[strings.Join(lvs, ",")]
+}
+
+func (tm *testMetric) Add(delta float64) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	tm.values[strings.Join(tm.lvs, ",")] += delta
+}
+
+func (tm *testMetric) Set(value float64) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	tm.values[strings.Join(tm.lvs, ",")] = value
+}
+
+func (tm *testMetric) Observe(value float64) { tm.Set(value) }
+
+type testCounter struct{ *testMetric }
+
+func (tc testCounter) With(lvs ...string) metrics.Counter { return testCounter{tc.with(lvs)} }
+
+type testGauge struct{ *testMetric }
+
+func (tg testGauge) With(lvs ...string) metrics.Gauge { return testGauge{tg.with(lvs)} }
+
+type testHistogram struct{ *testMetric }
+
+func (th testHistogram) With(lvs ...string) metrics.Histogram { return testHistogram{th.with(lvs)} }
+
+func TestMetricsCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-MBX-USED-WEIGHT-1M", "17")
+		w.WriteHeader(http.StatusTeapot)
+		w.Write([]byte(`{"code":-1003,"msg":"Too many requests."}`))
+	}))
+	defer server.Close()
+
+	requests, duration, errs := newTestMetric(), newTestMetric(), newTestMetric()
+	weight, limit := newTestMetric(), newTestMetric()
+	m := &Metrics{
+		Requests:        testCounter{requests},
+		RequestDuration: testHistogram{duration},
+		Errors:          testCounter{errs},
+		UsedWeight:      testGauge{weight},
+		WeightLimit:     testGauge{limit},
+	}
+	m.SetWeightLimit("api", 6000)
+	as := NewAPIService(server.URL, "key", nil, nil, nil, m.Middleware())
+
+	err := as.Ping()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lvs := []string{"endpoint", "api/v1/ping", "method", "GET", "status", "418"}
+	if requests.get(lvs...) != 1 {
+		t.Errorf("unexpected requests: %v", requests.values)
+	}
+	if _, ok := duration.values[strings.Join(lvs, ",")]; !ok {
+		t.Errorf("duration not observed: %v", duration.values)
+	}
+	if errs.get("endpoint", "api/v1/ping", "code", "-1003") != 1 {
+		t.Errorf("unexpected errors: %v", errs.values)
+	}
+	if weight.get("api", "api") != 17 || limit.get("api", "api") != 6000 {
+		t.Errorf("unexpected weight: %v, %v", weight.values, limit.values)
+	}
+
+	server.Close()
+	if err := as.Ping(); err == nil {
+		t.Fatal("expected error")
+	}
+	if requests.get("endpoint", "api/v1/ping", "method", "GET", "status", "error") != 1 {
+		t.Errorf("unexpected requests: %v", requests.values)
+	}
+}
+
+func TestMetricsWS(t *testing.T) {
+	eventTime := time.Now().Add(-250 * time.Millisecond)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		e := []byte(`{"e":"aggTrade","E":` + strconv.FormatInt(unixMillis(eventTime), 10) + `}`)
+		c.WriteMessage(websocket.TextMessage, e)
+		c.WriteMessage(websocket.TextMessage, e)
+	}))
+	defer server.Close()
+
+	connected, reconnects, messages, lag := newTestMetric(), newTestMetric(), newTestMetric(), newTestMetric()
+	m := &Metrics{
+		WSConnected:  testGauge{connected},
+		WSReconnects: testCounter{reconnects},
+		WSMessages:   testCounter{messages},
+		WSLag:        testHistogram{lag},
+	}
+	as := NewAPIService(server.URL, "key", nil, nil, context.Background(), m.Middleware()).(*apiService)
+
+	stream := "ltcbtc@aggTrade"
+	for i := 0; i < 2; i++ {
+		c, err := as.dial(stream, "ws"+strings.TrimPrefix(server.URL, "http"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if connected.get("stream", stream) != 1 {
+			t.Errorf("expected connected stream, got %v", connected.values)
+		}
+		done := make(chan struct{})
+		as.readWS(context.Background(), c, done, stream, func(message []byte) error { return nil })
+		<-done
+	}
+	if connected.get("stream", stream) != 0 {
+		t.Errorf("expected disconnected stream, got %v", connected.values)
+	}
+	if reconnects.get("stream", stream) != 1 {
+		t.Errorf("unexpected reconnects: %v", reconnects.values)
+	}
+	if messages.get("stream", stream) != 4 {
+		t.Errorf("unexpected messages: %v", messages.values)
+	}
+	if l := lag.get("stream", stream); l < 0.2 || l > 5 {
+		t.Errorf("unexpected lag: %v", l)
+	}
+}
@@ -0,0 +1,75 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Call represents single REST call passed through middleware chain.
+type Call struct {
+	Ctx      context.Context
+	Method   string
+	Endpoint string
+	// Params are passed unsigned, signature is added when the call is sent.
+	Params url.Values
+	// Header is added to HTTP request, e.g. for tracing headers.
+	Header http.Header
+	APIKey bool
+	Sign   bool
+}
+
+// CallHandler sends call and returns HTTP response.
+type CallHandler func(call *Call) (*http.Response, error)
+
+// WSMessage represents message received from websocket stream.
+type WSMessage struct {
+	// Stream is stream name, e.g. ltcbtc@depth. Listen key of user data
+	// stream is not exposed, userData is used instead.
+	Stream   string
+	Data     []byte
+	Received time.Time
+}
+
+// WSHandler dispatches websocket message. Stream is closed when error is
+// returned.
+type WSHandler func(msg *WSMessage) error
+
+// Middleware wraps REST calls and websocket message dispatch, so that
+// tracing, metrics or fault injection can be added to every call of Service.
+//
+// Any of functions may be nil. Middleware passed to NewAPIService first is
+// the outermost one.
+type Middleware struct {
+	Call func(next CallHandler) CallHandler
+	WS   func(next WSHandler) WSHandler
+	// WSState is notified when websocket stream connects or disconnects.
+	WSState func(stream string, connected bool)
+}
+
+func chainCall(h CallHandler, mw []Middleware) CallHandler {
+	for i := len(mw) - 1; i >= 0; i-- {
+		if mw[i].Call != nil {
+			h = mw[i].Call(h)
+		}
+	}
+	return h
+}
+
+func chainWS(h WSHandler, mw []Middleware) WSHandler {
+	for i := len(mw) - 1; i >= 0; i-- {
+		if mw[i].WS != nil {
+			h = mw[i].WS(h)
+		}
+	}
+	return h
+}
+
+func notifyWSState(mw []Middleware, stream string, connected bool) {
+	for _, m := range mw {
+		if m.WSState != nil {
+			m.WSState(stream, connected)
+		}
+	}
+}
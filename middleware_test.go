@@ -0,0 +1,118 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+)
+
+func TestMiddlewareCall(t *testing.T) {
+	var traceID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceID = r.Header.Get("X-Trace-ID")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var order []string
+	var endpoint string
+	var status int
+	mw := func(name string) Middleware {
+		return Middleware{
+			Call: func(next CallHandler) CallHandler {
+				return func(call *Call) (*http.Response, error) {
+					order = append(order, name)
+					call.Header.Set("X-Trace-ID", "trace-"+name)
+					endpoint = call.Endpoint
+					res, err := next(call)
+					if err == nil {
+						status = res.StatusCode
+					}
+					return res, err
+				}
+			},
+		}
+	}
+	as := NewAPIService(server.URL, "key", nil, nil, nil, mw("outer"), Middleware{}, mw("inner"))
+	if err := as.Ping(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(order, ",") != "outer,inner" {
+		t.Errorf("unexpected middleware order: %v", order)
+	}
+	if traceID != "trace-inner" || endpoint != "api/v1/ping" || status != 200 {
+		t.Errorf("unexpected call: %q, %q, %d", traceID, endpoint, status)
+	}
+}
+
+func TestMiddlewareFaultInjection(t *testing.T) {
+	errInjected := errors.New("injected")
+	var params string
+	as := NewAPIService("http://127.0.0.1:0", "key", &HmacSigner{Key: []byte("secret")}, nil, nil, Middleware{
+		Call: func(next CallHandler) CallHandler {
+			return func(call *Call) (*http.Response, error) {
+				params = call.Params.Encode()
+				return nil, errInjected
+			}
+		},
+	})
+	err := as.NewOrderTest(NewOrderRequest{Symbol: "LTCBTC", Side: SideBuy, Type: TypeMarket, Quantity: 1})
+	if errors.Cause(err) != errInjected {
+		t.Errorf("expected injected error, got %v", err)
+	}
+	if !strings.Contains(params, "symbol=LTCBTC") || strings.Contains(params, "signature") {
+		t.Errorf("expected unsigned params, got %q", params)
+	}
+}
+
+func TestMiddlewareWS(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		c.WriteMessage(websocket.TextMessage, []byte(`{"e":"one"}`))
+		c.WriteMessage(websocket.TextMessage, []byte(`{"e":"two"}`))
+		c.WriteMessage(websocket.TextMessage, []byte(`{"e":"three"}`))
+	}))
+	defer server.Close()
+
+	var streams []string
+	errStop := errors.New("stop")
+	as := NewAPIService(server.URL, "key", nil, nil, context.Background(), Middleware{
+		WS: func(next WSHandler) WSHandler {
+			return func(msg *WSMessage) error {
+				streams = append(streams, msg.Stream)
+				if strings.Contains(string(msg.Data), "two") {
+					return errStop
+				}
+				return next(msg)
+			}
+		},
+	}).(*apiService)
+
+	c, err := as.dial("ltcbtc@depth", "ws"+strings.TrimPrefix(server.URL, "http"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dispatched []string
+	done := make(chan struct{})
+	as.readWS(context.Background(), c, done, "ltcbtc@depth", func(message []byte) error {
+		dispatched = append(dispatched, string(message))
+		return nil
+	})
+	<-done
+	if len(dispatched) != 1 || dispatched[0] != `{"e":"one"}` {
+		t.Errorf("unexpected dispatched messages: %v", dispatched)
+	}
+	if strings.Join(streams, ",") != "ltcbtc@depth,ltcbtc@depth" {
+		t.Errorf("unexpected streams: %v", streams)
+	}
+}
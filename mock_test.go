@@ -38,6 +38,22 @@ func (m *ServiceMock) AggTrades(atr binance.AggTradesRequest) ([]*binance.AggTra
 	}
 	return atc, args.Error(1)
 }
+func (m *ServiceMock) RecentTrades(rtr binance.RecentTradesRequest) ([]*binance.MarketTrade, error) {
+	args := m.Called(rtr)
+	mtc, ok := args.Get(0).([]*binance.MarketTrade)
+	if !ok {
+		mtc = nil
+	}
+	return mtc, args.Error(1)
+}
+func (m *ServiceMock) HistoricalTrades(htr binance.HistoricalTradesRequest) ([]*binance.MarketTrade, error) {
+	args := m.Called(htr)
+	mtc, ok := args.Get(0).([]*binance.MarketTrade)
+	if !ok {
+		mtc = nil
+	}
+	return mtc, args.Error(1)
+}
 func (m *ServiceMock) Klines(kr binance.KlinesRequest) ([]*binance.Kline, error) {
 	args := m.Called(kr)
 	kc, ok := args.Get(0).([]*binance.Kline)
@@ -46,6 +62,14 @@ func (m *ServiceMock) Klines(kr binance.KlinesRequest) ([]*binance.Kline, error)
 	}
 	return kc, args.Error(1)
 }
+func (m *ServiceMock) UIKlines(kr binance.KlinesRequest) ([]*binance.Kline, error) {
+	args := m.Called(kr)
+	kc, ok := args.Get(0).([]*binance.Kline)
+	if !ok {
+		kc = nil
+	}
+	return kc, args.Error(1)
+}
 func (m *ServiceMock) Ticker24(tr binance.TickerRequest) (*binance.Ticker24, error) {
 	args := m.Called(tr)
 	t24, ok := args.Get(0).(*binance.Ticker24)
@@ -70,6 +94,54 @@ func (m *ServiceMock) TickerAllBooks() ([]*binance.BookTicker, error) {
 	}
 	return btc, args.Error(1)
 }
+func (m *ServiceMock) AvgPrice(apr binance.AvgPriceRequest) (*binance.AvgPrice, error) {
+	args := m.Called(apr)
+	ap, ok := args.Get(0).(*binance.AvgPrice)
+	if !ok {
+		ap = nil
+	}
+	return ap, args.Error(1)
+}
+func (m *ServiceMock) TickerPrice(tr binance.TickersRequest) ([]*binance.PriceTicker, error) {
+	args := m.Called(tr)
+	ptc, ok := args.Get(0).([]*binance.PriceTicker)
+	if !ok {
+		ptc = nil
+	}
+	return ptc, args.Error(1)
+}
+func (m *ServiceMock) BookTicker(tr binance.TickersRequest) ([]*binance.BookTicker, error) {
+	args := m.Called(tr)
+	btc, ok := args.Get(0).([]*binance.BookTicker)
+	if !ok {
+		btc = nil
+	}
+	return btc, args.Error(1)
+}
+func (m *ServiceMock) Tickers24(tr binance.TickersRequest) ([]*binance.Ticker24, error) {
+	args := m.Called(tr)
+	t24c, ok := args.Get(0).([]*binance.Ticker24)
+	if !ok {
+		t24c = nil
+	}
+	return t24c, args.Error(1)
+}
+func (m *ServiceMock) RollingTickers(rtr binance.RollingTickersRequest) ([]*binance.Ticker24, error) {
+	args := m.Called(rtr)
+	t24c, ok := args.Get(0).([]*binance.Ticker24)
+	if !ok {
+		t24c = nil
+	}
+	return t24c, args.Error(1)
+}
+func (m *ServiceMock) TradingDayTickers(tr binance.TradingDayTickersRequest) ([]*binance.Ticker24, error) {
+	args := m.Called(tr)
+	t24c, ok := args.Get(0).([]*binance.Ticker24)
+	if !ok {
+		t24c = nil
+	}
+	return t24c, args.Error(1)
+}
 func (m *ServiceMock) NewOrder(or binance.NewOrderRequest) (*binance.ProcessedOrder, error) {
 	args := m.Called(or)
 	ob, ok := args.Get(0).(*binance.ProcessedOrder)
@@ -138,7 +210,7 @@ func (m *ServiceMock) Withdraw(wr binance.WithdrawRequest) (*binance.WithdrawRes
 	}
 	return wres, args.Error(1)
 }
-func (m *ServiceMock) DepositHistory(hr binance.HistoryRequest) ([]*binance.Deposit, error) {
+func (m *ServiceMock) DepositHistory(hr binance.DepositHistoryRequest) ([]*binance.Deposit, error) {
 	args := m.Called(hr)
 	dc, ok := args.Get(0).([]*binance.Deposit)
 	if !ok {
@@ -146,7 +218,7 @@ func (m *ServiceMock) DepositHistory(hr binance.HistoryRequest) ([]*binance.Depo
 	}
 	return dc, args.Error(1)
 }
-func (m *ServiceMock) WithdrawHistory(hr binance.HistoryRequest) ([]*binance.Withdrawal, error) {
+func (m *ServiceMock) WithdrawHistory(hr binance.WithdrawHistoryRequest) ([]*binance.Withdrawal, error) {
 	args := m.Called(hr)
 	wc, ok := args.Get(0).([]*binance.Withdrawal)
 	if !ok {
@@ -154,6 +226,22 @@ func (m *ServiceMock) WithdrawHistory(hr binance.HistoryRequest) ([]*binance.Wit
 	}
 	return wc, args.Error(1)
 }
+func (m *ServiceMock) CoinConfig(ccr binance.CoinConfigRequest) ([]*binance.CoinConfig, error) {
+	args := m.Called(ccr)
+	ccc, ok := args.Get(0).([]*binance.CoinConfig)
+	if !ok {
+		ccc = nil
+	}
+	return ccc, args.Error(1)
+}
+func (m *ServiceMock) DepositAddress(dar binance.DepositAddressRequest) (*binance.DepositAddress, error) {
+	args := m.Called(dar)
+	da, ok := args.Get(0).(*binance.DepositAddress)
+	if !ok {
+		da = nil
+	}
+	return da, args.Error(1)
+}
 func (m *ServiceMock) StartUserDataStream() (*binance.Stream, error) {
 	args := m.Called()
 	s, ok := args.Get(0).(*binance.Stream)
@@ -200,7 +288,7 @@ func (m *ServiceMock) TradeWebsocket(twr binance.TradeWebsocketRequest) (chan *b
 	if !ok {
 		atech = nil
 	}
-	sch, ok := args.Get(0).(chan struct{})
+	sch, ok := args.Get(1).(chan struct{})
 	if !ok {
 		sch = nil
 	}
@@ -218,3 +306,511 @@ func (m *ServiceMock) UserDataWebsocket(udwr binance.UserDataWebsocketRequest) (
 	}
 	return aech, sch, args.Error(2)
 }
+func (m *ServiceMock) NewMarginOrder(or binance.NewMarginOrderRequest) (*binance.ProcessedOrder, error) {
+	args := m.Called(or)
+	ob, ok := args.Get(0).(*binance.ProcessedOrder)
+	if !ok {
+		ob = nil
+	}
+	return ob, args.Error(1)
+}
+func (m *ServiceMock) NewMarginOrderTest(or binance.NewMarginOrderRequest) error {
+	args := m.Called(or)
+	return args.Error(0)
+}
+func (m *ServiceMock) QueryMarginOrder(qor binance.QueryOrderRequest) (*binance.ExecutedOrder, error) {
+	args := m.Called(qor)
+	eo, ok := args.Get(0).(*binance.ExecutedOrder)
+	if !ok {
+		eo = nil
+	}
+	return eo, args.Error(1)
+}
+func (m *ServiceMock) CancelMarginOrder(cor binance.CancelOrderRequest) (*binance.CanceledOrder, error) {
+	args := m.Called(cor)
+	co, ok := args.Get(0).(*binance.CanceledOrder)
+	if !ok {
+		co = nil
+	}
+	return co, args.Error(1)
+}
+func (m *ServiceMock) OpenMarginOrders(oor binance.OpenOrdersRequest) ([]*binance.ExecutedOrder, error) {
+	args := m.Called(oor)
+	eoc, ok := args.Get(0).([]*binance.ExecutedOrder)
+	if !ok {
+		eoc = nil
+	}
+	return eoc, args.Error(1)
+}
+func (m *ServiceMock) AllMarginOrders(aor binance.AllOrdersRequest) ([]*binance.ExecutedOrder, error) {
+	args := m.Called(aor)
+	eoc, ok := args.Get(0).([]*binance.ExecutedOrder)
+	if !ok {
+		eoc = nil
+	}
+	return eoc, args.Error(1)
+}
+func (m *ServiceMock) MarginAccount(ar binance.AccountRequest) (*binance.MarginAccount, error) {
+	args := m.Called(ar)
+	a, ok := args.Get(0).(*binance.MarginAccount)
+	if !ok {
+		a = nil
+	}
+	return a, args.Error(1)
+}
+func (m *ServiceMock) MyMarginTrades(mtr binance.MyTradesRequest) ([]*binance.Trade, error) {
+	args := m.Called(mtr)
+	tc, ok := args.Get(0).([]*binance.Trade)
+	if !ok {
+		tc = nil
+	}
+	return tc, args.Error(1)
+}
+func (m *ServiceMock) AllMarginAssets(ar binance.AccountRequest) ([]*binance.MarginAsset, error) {
+	args := m.Called(ar)
+	mac, ok := args.Get(0).([]*binance.MarginAsset)
+	if !ok {
+		mac = nil
+	}
+	return mac, args.Error(1)
+}
+func (m *ServiceMock) MaxBorrow(mbr binance.MaxMarginRequest) (*binance.MaxBorrowable, error) {
+	args := m.Called(mbr)
+	mb, ok := args.Get(0).(*binance.MaxBorrowable)
+	if !ok {
+		mb = nil
+	}
+	return mb, args.Error(1)
+}
+func (m *ServiceMock) MaxTransfer(mbr binance.MaxMarginRequest) (float64, error) {
+	args := m.Called(mbr)
+	return args.Get(0).(float64), args.Error(1)
+}
+func (m *ServiceMock) UniversalTransfer(utr binance.UniversalTransferRequest) (*binance.TransferResult, error) {
+	args := m.Called(utr)
+	r, ok := args.Get(0).(*binance.TransferResult)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) UniversalTransferHistory(uthr binance.UniversalTransferHistoryRequest) (*binance.TransferHistory, error) {
+	args := m.Called(uthr)
+	r, ok := args.Get(0).(*binance.TransferHistory)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) MarginTransfer(mtr binance.MarginTransferRequest) (*binance.TransferResult, error) {
+	args := m.Called(mtr)
+	r, ok := args.Get(0).(*binance.TransferResult)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) IsolatedMarginTransfer(imtr binance.IsolatedMarginTransferRequest) (*binance.TransferResult, error) {
+	args := m.Called(imtr)
+	r, ok := args.Get(0).(*binance.TransferResult)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) MarginTransferHistory(mthr binance.MarginTransferHistoryRequest) (*binance.MarginTransferHistory, error) {
+	args := m.Called(mthr)
+	r, ok := args.Get(0).(*binance.MarginTransferHistory)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) MarginBorrow(mlr binance.MarginLoanRequest) (*binance.MarginLoanResult, error) {
+	args := m.Called(mlr)
+	r, ok := args.Get(0).(*binance.MarginLoanResult)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) MarginRepay(mlr binance.MarginLoanRequest) (*binance.MarginLoanResult, error) {
+	args := m.Called(mlr)
+	r, ok := args.Get(0).(*binance.MarginLoanResult)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) MarginLoanRecords(mlrr binance.MarginLoanRecordsRequest) (*binance.MarginLoanHistory, error) {
+	args := m.Called(mlrr)
+	r, ok := args.Get(0).(*binance.MarginLoanHistory)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) MarginRepayRecords(mlrr binance.MarginLoanRecordsRequest) (*binance.MarginLoanHistory, error) {
+	args := m.Called(mlrr)
+	r, ok := args.Get(0).(*binance.MarginLoanHistory)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) IsolatedMarginAccount(imar binance.IsolatedMarginAccountRequest) (*binance.IsolatedMarginAccount, error) {
+	args := m.Called(imar)
+	r, ok := args.Get(0).(*binance.IsolatedMarginAccount)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) EnableIsolatedMarginAccount(isr binance.IsolatedSymbolRequest) error {
+	args := m.Called(isr)
+	return args.Error(0)
+}
+func (m *ServiceMock) DisableIsolatedMarginAccount(isr binance.IsolatedSymbolRequest) error {
+	args := m.Called(isr)
+	return args.Error(0)
+}
+func (m *ServiceMock) AllIsolatedMarginSymbols(isr binance.IsolatedSymbolRequest) ([]*binance.IsolatedMarginSymbol, error) {
+	args := m.Called(isr)
+	r, ok := args.Get(0).([]*binance.IsolatedMarginSymbol)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) CrossMarginCollateralRatio() ([]*binance.CollateralRatio, error) {
+	args := m.Called()
+	r, ok := args.Get(0).([]*binance.CollateralRatio)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) MarginInterestHistory(ihr binance.InterestHistoryRequest) (*binance.InterestHistory, error) {
+	args := m.Called(ihr)
+	r, ok := args.Get(0).(*binance.InterestHistory)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) ForceLiquidations(flr binance.ForceLiquidationRequest) (*binance.ForceLiquidationHistory, error) {
+	args := m.Called(flr)
+	r, ok := args.Get(0).(*binance.ForceLiquidationHistory)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) MarginInterestRateHistory(irhr binance.InterestRateHistoryRequest) ([]*binance.InterestRate, error) {
+	args := m.Called(irhr)
+	r, ok := args.Get(0).([]*binance.InterestRate)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) MarginPriceIndex(mpir binance.MarginPriceIndexRequest) (*binance.MarginPriceIndex, error) {
+	args := m.Called(mpir)
+	r, ok := args.Get(0).(*binance.MarginPriceIndex)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) CancelOpenMarginOrders(oor binance.OpenOrdersRequest) ([]*binance.CanceledOrder, error) {
+	args := m.Called(oor)
+	r, ok := args.Get(0).([]*binance.CanceledOrder)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) NewMarginOCO(nor binance.NewMarginOCORequest) (*binance.OrderList, error) {
+	args := m.Called(nor)
+	r, ok := args.Get(0).(*binance.OrderList)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) QueryMarginOCO(olr binance.OrderListRequest) (*binance.OrderList, error) {
+	args := m.Called(olr)
+	r, ok := args.Get(0).(*binance.OrderList)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) CancelMarginOCO(olr binance.OrderListRequest) (*binance.OrderList, error) {
+	args := m.Called(olr)
+	r, ok := args.Get(0).(*binance.OrderList)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) MarginOrderCount(mocr binance.MarginOrderCountRequest) ([]*binance.OrderCount, error) {
+	args := m.Called(mocr)
+	r, ok := args.Get(0).([]*binance.OrderCount)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) AccountSnapshot(asr binance.AccountSnapshotRequest) ([]*binance.AccountSnapshot, error) {
+	args := m.Called(asr)
+	r, ok := args.Get(0).([]*binance.AccountSnapshot)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) APIKeyPermission(ar binance.AccountRequest) (*binance.APIKeyPermission, error) {
+	args := m.Called(ar)
+	r, ok := args.Get(0).(*binance.APIKeyPermission)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) AccountStatus(ar binance.AccountRequest) (*binance.AccountStatus, error) {
+	args := m.Called(ar)
+	r, ok := args.Get(0).(*binance.AccountStatus)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) APITradingStatus(ar binance.AccountRequest) (*binance.APITradingStatus, error) {
+	args := m.Called(ar)
+	r, ok := args.Get(0).(*binance.APITradingStatus)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) TradeFee(tfr binance.TradeFeeRequest) ([]*binance.TradeFee, error) {
+	args := m.Called(tfr)
+	r, ok := args.Get(0).([]*binance.TradeFee)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) Commission(cr binance.CommissionRequest) (*binance.AccountCommission, error) {
+	args := m.Called(cr)
+	r, ok := args.Get(0).(*binance.AccountCommission)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) DustLog(dlr binance.DustLogRequest) (*binance.DustLog, error) {
+	args := m.Called(dlr)
+	r, ok := args.Get(0).(*binance.DustLog)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) DustAssets(ar binance.AccountRequest) (*binance.DustAssets, error) {
+	args := m.Called(ar)
+	r, ok := args.Get(0).(*binance.DustAssets)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) DustTransfer(dtr binance.DustTransferRequest) (*binance.DustTransferResult, error) {
+	args := m.Called(dtr)
+	r, ok := args.Get(0).(*binance.DustTransferResult)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) AssetDividendRecord(adr binance.AssetDividendRequest) (*binance.AssetDividendHistory, error) {
+	args := m.Called(adr)
+	r, ok := args.Get(0).(*binance.AssetDividendHistory)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) AssetDetail(adr binance.AssetDetailRequest) (map[string]*binance.AssetDetail, error) {
+	args := m.Called(adr)
+	r, ok := args.Get(0).(map[string]*binance.AssetDetail)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) SubAccounts(sar binance.SubAccountsRequest) ([]*binance.SubAccount, error) {
+	args := m.Called(sar)
+	r, ok := args.Get(0).([]*binance.SubAccount)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) SubAccountAssets(sar binance.SubAccountRequest) ([]*binance.Balance, error) {
+	args := m.Called(sar)
+	r, ok := args.Get(0).([]*binance.Balance)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) SubAccountMarginAccount(sar binance.SubAccountRequest) (*binance.SubAccountMarginAccount, error) {
+	args := m.Called(sar)
+	r, ok := args.Get(0).(*binance.SubAccountMarginAccount)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) SubAccountTransfer(str binance.SubAccountTransferRequest) (*binance.SubAccountTransferResult, error) {
+	args := m.Called(str)
+	r, ok := args.Get(0).(*binance.SubAccountTransferResult)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) SubAccountTransferHistory(sthr binance.SubAccountTransferHistoryRequest) (*binance.SubAccountTransferHistory, error) {
+	args := m.Called(sthr)
+	r, ok := args.Get(0).(*binance.SubAccountTransferHistory)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) CreateVirtualSubAccount(cvr binance.CreateVirtualSubAccountRequest) (string, error) {
+	args := m.Called(cvr)
+	return args.String(0), args.Error(1)
+}
+func (m *ServiceMock) FlexibleEarnProducts(epr binance.EarnProductsRequest) (*binance.FlexibleEarnProductList, error) {
+	args := m.Called(epr)
+	r, ok := args.Get(0).(*binance.FlexibleEarnProductList)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) LockedEarnProducts(epr binance.EarnProductsRequest) (*binance.LockedEarnProductList, error) {
+	args := m.Called(epr)
+	r, ok := args.Get(0).(*binance.LockedEarnProductList)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) SubscribeFlexibleEarn(sfr binance.SubscribeFlexibleEarnRequest) (*binance.EarnSubscription, error) {
+	args := m.Called(sfr)
+	r, ok := args.Get(0).(*binance.EarnSubscription)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) SubscribeLockedEarn(slr binance.SubscribeLockedEarnRequest) (*binance.EarnSubscription, error) {
+	args := m.Called(slr)
+	r, ok := args.Get(0).(*binance.EarnSubscription)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) RedeemFlexibleEarn(rfr binance.RedeemFlexibleEarnRequest) (*binance.EarnRedemption, error) {
+	args := m.Called(rfr)
+	r, ok := args.Get(0).(*binance.EarnRedemption)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) RedeemLockedEarn(rlr binance.RedeemLockedEarnRequest) (*binance.EarnRedemption, error) {
+	args := m.Called(rlr)
+	r, ok := args.Get(0).(*binance.EarnRedemption)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) FlexibleEarnPositions(fpr binance.FlexibleEarnPositionsRequest) (*binance.FlexibleEarnPositionList, error) {
+	args := m.Called(fpr)
+	r, ok := args.Get(0).(*binance.FlexibleEarnPositionList)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) LockedEarnPositions(lpr binance.LockedEarnPositionsRequest) (*binance.LockedEarnPositionList, error) {
+	args := m.Called(lpr)
+	r, ok := args.Get(0).(*binance.LockedEarnPositionList)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) FlexibleEarnRewards(frr binance.FlexibleEarnRewardsRequest) (*binance.FlexibleEarnRewardHistory, error) {
+	args := m.Called(frr)
+	r, ok := args.Get(0).(*binance.FlexibleEarnRewardHistory)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) LockedEarnRewards(lrr binance.LockedEarnRewardsRequest) (*binance.LockedEarnRewardHistory, error) {
+	args := m.Called(lrr)
+	r, ok := args.Get(0).(*binance.LockedEarnRewardHistory)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) ConvertExchangeInfo(cpr binance.ConvertPairsRequest) ([]*binance.ConvertPair, error) {
+	args := m.Called(cpr)
+	r, ok := args.Get(0).([]*binance.ConvertPair)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) ConvertGetQuote(cqr binance.ConvertQuoteRequest) (*binance.ConvertQuote, error) {
+	args := m.Called(cqr)
+	r, ok := args.Get(0).(*binance.ConvertQuote)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) ConvertAcceptQuote(car binance.ConvertAcceptRequest) (*binance.ConvertOrder, error) {
+	args := m.Called(car)
+	r, ok := args.Get(0).(*binance.ConvertOrder)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) ConvertOrderStatus(cosr binance.ConvertOrderStatusRequest) (*binance.ConvertOrder, error) {
+	args := m.Called(cosr)
+	r, ok := args.Get(0).(*binance.ConvertOrder)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
+func (m *ServiceMock) ConvertTradeHistory(cthr binance.ConvertTradeHistoryRequest) (*binance.ConvertTradeHistory, error) {
+	args := m.Called(cthr)
+	r, ok := args.Get(0).(*binance.ConvertTradeHistory)
+	if !ok {
+		r = nil
+	}
+	return r, args.Error(1)
+}
@@ -0,0 +1,163 @@
+package binance
+
+import (
+	"io"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errCodeUnknown         = -1000
+	errCodeDisconnected    = -1001
+	errCodeUnexpectedResp  = -1006
+	errCodeTimeout         = -1007
+	errCodeNoSuchOrder     = -2013
+	defaultPlaceAttempts   = 5
+	defaultPlaceRetryDelay = time.Second
+)
+
+// OrderPlacer places orders idempotently.
+//
+// Every order gets client order ID before it is sent. When the outcome of
+// NewOrder is unknown (timeout, dropped connection or backend timeout error),
+// OrderPlacer queries the order by its client order ID and sends it again only
+// if exchange reports that the order doesn't exist. Order is therefore never
+// placed twice after a dropped connection. The same client order ID is reused
+// when the order is resent, so exchange rejects duplicates of an order that is
+// still open.
+type OrderPlacer struct {
+	Binance Binance
+	IDs     ClientOrderIDGenerator
+	// Tag is strategy tag passed to IDs.
+	Tag string
+	// MaxAttempts limits number of NewOrder and QueryOrder calls, 5 by default.
+	MaxAttempts int
+	// RetryDelay is the pause between attempts, 1s by default.
+	RetryDelay time.Duration
+}
+
+// NewOrderPlacer returns OrderPlacer with default retry settings.
+func NewOrderPlacer(b Binance, ids ClientOrderIDGenerator, tag string) *OrderPlacer {
+	return &OrderPlacer{
+		Binance:     b,
+		IDs:         ids,
+		Tag:         tag,
+		MaxAttempts: defaultPlaceAttempts,
+		RetryDelay:  defaultPlaceRetryDelay,
+	}
+}
+
+// PlaceOrder places new order and returns ProcessedOrder.
+//
+// NewClientOrderID is generated when empty. When the order is recovered by
+// query instead of NewOrder response, TransactTime holds order creation time.
+func (op *OrderPlacer) PlaceOrder(nor NewOrderRequest) (*ProcessedOrder, error) {
+	if nor.NewClientOrderID == "" {
+		if op.IDs == nil {
+			return nil, errors.New("client order ID generator not set")
+		}
+		nor.NewClientOrderID = op.IDs.NextID(op.Tag)
+	}
+	attempts := op.MaxAttempts
+	if attempts <= 0 {
+		attempts = defaultPlaceAttempts
+	}
+
+	send := true
+	var lastErr error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			time.Sleep(op.RetryDelay)
+		}
+		if send {
+			nor.Timestamp = time.Now()
+			po, err := op.Binance.NewOrder(nor)
+			if err == nil {
+				return po, nil
+			}
+			if !isAmbiguousError(err) {
+				return nil, err
+			}
+			lastErr = err
+			send = false
+			continue
+		}
+
+		eo, err := op.Binance.QueryOrder(QueryOrderRequest{
+			Symbol:            nor.Symbol,
+			OrigClientOrderID: nor.NewClientOrderID,
+			Timestamp:         time.Now(),
+		})
+		if err == nil {
+			return processedOrderFromExecuted(eo), nil
+		}
+		if apiErr, ok := apiError(err); ok && apiErr.Code == errCodeNoSuchOrder {
+			send = true
+			continue
+		}
+		if !isAmbiguousError(err) {
+			return nil, err
+		}
+		lastErr = err
+	}
+	if send {
+		return nil, errors.Wrap(lastErr, "order not placed, client order ID "+nor.NewClientOrderID)
+	}
+	return nil, errors.Wrap(lastErr, "order state unknown, client order ID "+nor.NewClientOrderID)
+}
+
+func processedOrderFromExecuted(eo *ExecutedOrder) *ProcessedOrder {
+	return &ProcessedOrder{
+		Symbol:             eo.Symbol,
+		OrderID:            int64(eo.OrderID),
+		ClientOrderID:      eo.ClientOrderID,
+		TransactTime:       eo.Time,
+		Price:              eo.Price,
+		OrigQty:            eo.OrigQty,
+		ExecutedQty:        eo.ExecutedQty,
+		CumulativeQuoteQty: eo.CumulativeQuoteQty,
+		Status:             eo.Status,
+		TimeInForce:        eo.TimeInForce,
+		Type:               eo.Type,
+		Side:               eo.Side,
+	}
+}
+
+func apiError(err error) (*Error, bool) {
+	switch e := errors.Cause(err).(type) {
+	case *Error:
+		return e, true
+	case Error:
+		return &e, true
+	}
+	return nil, false
+}
+
+// isAmbiguousError reports whether request might have been executed by
+// exchange even though it returned an error.
+//
+// Binance documents 5XX responses as execution status unknown, so any 5XX and
+// any response without Binance error are ambiguous.
+func isAmbiguousError(err error) bool {
+	switch errors.Cause(err).(type) {
+	case *HTTPError, HTTPError:
+		return true
+	}
+	if apiErr, ok := apiError(err); ok {
+		if apiErr.StatusCode >= 500 {
+			return true
+		}
+		switch apiErr.Code {
+		case errCodeUnknown, errCodeDisconnected, errCodeUnexpectedResp, errCodeTimeout:
+			return true
+		}
+		return false
+	}
+	cause := errors.Cause(err)
+	if _, ok := cause.(net.Error); ok {
+		return true
+	}
+	return cause == io.EOF || cause == io.ErrUnexpectedEOF
+}
@@ -0,0 +1,155 @@
+package binance_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/binance-exchange/go-binance"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+var _ net.Error = timeoutError{}
+
+func newTestOrderPlacer(binanceService *ServiceMock) *binance.OrderPlacer {
+	op := binance.NewOrderPlacer(binance.NewBinance(binanceService), binance.NewSequenceIDGenerator("test", 0), "t")
+	op.RetryDelay = 0
+	return op
+}
+
+func testNewOrderRequest() binance.NewOrderRequest {
+	return binance.NewOrderRequest{
+		Symbol:      "BNBETH",
+		Quantity:    1,
+		Price:       999,
+		Side:        binance.SideSell,
+		TimeInForce: binance.GTC,
+		Type:        binance.TypeLimit,
+	}
+}
+
+func withClientOrderID(id string) interface{} {
+	return mock.MatchedBy(func(nor binance.NewOrderRequest) bool {
+		return nor.NewClientOrderID == id
+	})
+}
+
+func TestPlaceOrder(t *testing.T) {
+	binanceService := &ServiceMock{}
+	op := newTestOrderPlacer(binanceService)
+
+	po := &binance.ProcessedOrder{Symbol: "BNBETH", OrderID: 1, ClientOrderID: "test-t-1"}
+	binanceService.On("NewOrder", withClientOrderID("test-t-1")).Return(po, nil).Once()
+
+	po_r, err := op.PlaceOrder(testNewOrderRequest())
+	assert.Nil(t, err)
+	assert.Equal(t, po, po_r)
+	binanceService.AssertExpectations(t)
+}
+
+func TestPlaceOrderRecoversAfterTimeout(t *testing.T) {
+	binanceService := &ServiceMock{}
+	op := newTestOrderPlacer(binanceService)
+
+	eo := &binance.ExecutedOrder{
+		Symbol:        "BNBETH",
+		OrderID:       7,
+		ClientOrderID: "test-t-1",
+		Status:        binance.StatusNew,
+		Time:          time.Now(),
+	}
+	binanceService.On("NewOrder", withClientOrderID("test-t-1")).Return(nil, timeoutError{}).Once()
+	binanceService.On("QueryOrder", mock.MatchedBy(func(qor binance.QueryOrderRequest) bool {
+		return qor.OrigClientOrderID == "test-t-1"
+	})).Return(eo, nil).Once()
+
+	po, err := op.PlaceOrder(testNewOrderRequest())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), po.OrderID)
+	assert.Equal(t, eo.Time, po.TransactTime)
+	binanceService.AssertExpectations(t)
+}
+
+func TestPlaceOrderResendsMissingOrder(t *testing.T) {
+	binanceService := &ServiceMock{}
+	op := newTestOrderPlacer(binanceService)
+
+	po := &binance.ProcessedOrder{Symbol: "BNBETH", OrderID: 1, ClientOrderID: "test-t-1"}
+	binanceService.On("NewOrder", withClientOrderID("test-t-1")).Return(nil, &binance.Error{Code: -1007}).Once()
+	binanceService.On("QueryOrder", mock.Anything).Return(nil, &binance.Error{Code: -2013}).Once()
+	binanceService.On("NewOrder", withClientOrderID("test-t-1")).Return(po, nil).Once()
+
+	po_r, err := op.PlaceOrder(testNewOrderRequest())
+	assert.Nil(t, err)
+	assert.Equal(t, po, po_r)
+	binanceService.AssertExpectations(t)
+}
+
+func TestPlaceOrderDefiniteError(t *testing.T) {
+	binanceService := &ServiceMock{}
+	op := newTestOrderPlacer(binanceService)
+
+	apiErr := &binance.Error{Code: -2010, Message: "Account has insufficient balance for requested action."}
+	binanceService.On("NewOrder", mock.Anything).Return(nil, apiErr).Once()
+
+	_, err := op.PlaceOrder(testNewOrderRequest())
+	assert.Equal(t, apiErr, err)
+	binanceService.AssertExpectations(t)
+}
+
+func TestPlaceOrderUnknownState(t *testing.T) {
+	binanceService := &ServiceMock{}
+	op := newTestOrderPlacer(binanceService)
+	op.MaxAttempts = 2
+
+	binanceService.On("NewOrder", mock.Anything).Return(nil, timeoutError{}).Once()
+	binanceService.On("QueryOrder", mock.Anything).Return(nil, timeoutError{}).Once()
+
+	_, err := op.PlaceOrder(testNewOrderRequest())
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "order state unknown")
+	binanceService.AssertExpectations(t)
+}
+
+func TestPlaceOrderRecoversAfterGatewayError(t *testing.T) {
+	var posts, gets int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			posts++
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("<html><body><h1>503 Service Temporarily Unavailable</h1></body></html>"))
+		case "GET":
+			gets++
+			w.Write([]byte(`{"symbol":"BNBETH","orderId":7,"clientOrderId":"test-t-1","price":"999",` +
+				`"origQty":"1","executedQty":"0","cummulativeQuoteQty":"0","status":"NEW",` +
+				`"timeInForce":"GTC","type":"LIMIT","side":"SELL","stopPrice":"0","icebergQty":"0",` +
+				`"time":1499827319559}`))
+		}
+	}))
+	defer server.Close()
+
+	binanceService := binance.NewAPIService(server.URL, "key", &binance.HmacSigner{Key: []byte("secret")},
+		nil, context.Background())
+	op := binance.NewOrderPlacer(binance.NewBinance(binanceService), binance.NewSequenceIDGenerator("test", 0), "t")
+	op.RetryDelay = 0
+
+	po, err := op.PlaceOrder(testNewOrderRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int64(7), po.OrderID)
+	assert.Equal(t, 1, posts)
+	assert.Equal(t, 1, gets)
+}
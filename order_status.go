@@ -13,6 +13,9 @@ type MarginOrderSideEffect string
 
 type NewOrderRespType string
 
+// SelfTradePreventionMode represents self-trade prevention mode enum.
+type SelfTradePreventionMode string
+
 var (
 	StatusNew             = OrderStatus("NEW")
 	StatusPartiallyFilled = OrderStatus("PARTIALLY_FILLED")
@@ -22,15 +25,26 @@ var (
 	StatusRejected        = OrderStatus("REJECTED")
 	StatusExpired         = OrderStatus("EXPIRED")
 
-	TypeLimit  = OrderType("LIMIT")
-	TypeMarket = OrderType("MARKET")
+	TypeLimit           = OrderType("LIMIT")
+	TypeMarket          = OrderType("MARKET")
+	TypeStopLoss        = OrderType("STOP_LOSS")
+	TypeStopLossLimit   = OrderType("STOP_LOSS_LIMIT")
+	TypeTakeProfit      = OrderType("TAKE_PROFIT")
+	TypeTakeProfitLimit = OrderType("TAKE_PROFIT_LIMIT")
+	TypeLimitMaker      = OrderType("LIMIT_MAKER")
 
 	SideBuy  = OrderSide("BUY")
 	SideSell = OrderSide("SELL")
 
-	SideEffectNo        = MarginOrderSideEffect("NO_SIDE_EFFECT")
-	SideEffectMarginBuy = MarginOrderSideEffect("MARGIN_BUY")
-	SideEffectAutoRepay = MarginOrderSideEffect("AUTO_REPAY")
+	SideEffectNo              = MarginOrderSideEffect("NO_SIDE_EFFECT")
+	SideEffectMarginBuy       = MarginOrderSideEffect("MARGIN_BUY")
+	SideEffectAutoRepay       = MarginOrderSideEffect("AUTO_REPAY")
+	SideEffectAutoBorrowRepay = MarginOrderSideEffect("AUTO_BORROW_REPAY")
+
+	STPNone        = SelfTradePreventionMode("NONE")
+	STPExpireTaker = SelfTradePreventionMode("EXPIRE_TAKER")
+	STPExpireMaker = SelfTradePreventionMode("EXPIRE_MAKER")
+	STPExpireBoth  = SelfTradePreventionMode("EXPIRE_BOTH")
 
 	OrderRespTypeAck    = NewOrderRespType("ACK")
 	OrderRespTypeResult = NewOrderRespType("RESULT")
@@ -0,0 +1,438 @@
+package binance
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultPageSize = 1000
+	aggTradesWindow = time.Hour
+	historyWindow   = 24 * time.Hour
+	dividendWindow  = 180 * 24 * time.Hour
+	dividendLimit   = 500
+)
+
+// ErrStopIteration can be returned from iterator callback to stop iteration
+// without error.
+var ErrStopIteration = errors.New("stop iteration")
+
+// Limiter blocks until next request is allowed.
+//
+// It is satisfied by *rate.Limiter from golang.org/x/time/rate.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// Paginator walks historical endpoints page by page.
+//
+// Each iterator passes results to callback in ascending order, rows repeated
+// on page boundaries are passed only once. Callback may return
+// ErrStopIteration to finish early.
+type Paginator struct {
+	Binance Binance
+	// Limiter is consulted before each page request, if set.
+	Limiter Limiter
+	// PageSize is the limit used for each page, 1000 by default.
+	PageSize int
+	Ctx      context.Context
+}
+
+// NewPaginator returns Paginator with default page size.
+func NewPaginator(b Binance, limiter Limiter) *Paginator {
+	return &Paginator{
+		Binance:  b,
+		Limiter:  limiter,
+		PageSize: defaultPageSize,
+		Ctx:      context.Background(),
+	}
+}
+
+// KlinesIter passes klines opened between from and to to fn.
+func (p *Paginator) KlinesIter(symbol string, interval Interval, from, to time.Time, fn func(*Kline) error) error {
+	limit := p.pageSize()
+	start := unixMillis(from)
+	last := int64(-1)
+	for {
+		if err := p.wait(); err != nil {
+			return err
+		}
+		kc, err := p.Binance.Klines(KlinesRequest{
+			Symbol:    symbol,
+			Interval:  interval,
+			Limit:     limit,
+			StartTime: start,
+			EndTime:   unixMillis(to),
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range kc {
+			ot := unixMillis(k.OpenTime)
+			if ot <= last {
+				continue
+			}
+			if k.OpenTime.After(to) {
+				return nil
+			}
+			last = ot
+			if err := fn(k); err != nil {
+				return stopIteration(err)
+			}
+		}
+		if len(kc) < limit || last < start {
+			return nil
+		}
+		start = last + 1
+	}
+}
+
+// AggTradesIter passes aggregate trades executed between from and to to fn.
+func (p *Paginator) AggTradesIter(symbol string, from, to time.Time, fn func(*AggTrade) error) error {
+	fromID, ok, err := p.firstID(from, to, aggTradesWindow, func(start, end time.Time) (int64, bool, error) {
+		atc, err := p.Binance.AggTrades(AggTradesRequest{
+			Symbol:    symbol,
+			StartTime: unixMillis(start),
+			EndTime:   unixMillis(end),
+			Limit:     1,
+		})
+		if err != nil || len(atc) == 0 {
+			return 0, false, err
+		}
+		return int64(atc[0].ID), true, nil
+	})
+	if err != nil || !ok {
+		return err
+	}
+	return p.aggTrades(symbol, fromID, to, fn)
+}
+
+// AggTradesIterFromID passes aggregate trades starting with ID fromID to fn
+// until the most recent one.
+func (p *Paginator) AggTradesIterFromID(symbol string, fromID int64, fn func(*AggTrade) error) error {
+	return p.aggTrades(symbol, fromID, time.Time{}, fn)
+}
+
+func (p *Paginator) aggTrades(symbol string, fromID int64, to time.Time, fn func(*AggTrade) error) error {
+	limit := p.pageSize()
+	last := fromID - 1
+	for {
+		if err := p.wait(); err != nil {
+			return err
+		}
+		atc, err := p.Binance.AggTrades(AggTradesRequest{
+			Symbol: symbol,
+			FromID: last + 1,
+			Limit:  limit,
+		})
+		if err != nil {
+			return err
+		}
+		prev := last
+		for _, at := range atc {
+			if int64(at.ID) <= last {
+				continue
+			}
+			if !to.IsZero() && at.Timestamp.After(to) {
+				return nil
+			}
+			last = int64(at.ID)
+			if err := fn(at); err != nil {
+				return stopIteration(err)
+			}
+		}
+		if len(atc) < limit || last == prev {
+			return nil
+		}
+	}
+}
+
+// HistoricalTradesIter passes market trades to fn walking backwards by ID,
+// starting with ID fromID. Iteration starts with the most recent trade when
+// fromID is negative.
+//
+// Unlike other iterators, trades are passed in descending order.
+func (p *Paginator) HistoricalTradesIter(symbol string, fromID int64, fn func(*MarketTrade) error) error {
+	limit := p.pageSize()
+	last := fromID + 1
+	if fromID < 0 {
+		if err := p.wait(); err != nil {
+			return err
+		}
+		mtc, err := p.Binance.HistoricalTrades(HistoricalTradesRequest{
+			Symbol: symbol,
+			Limit:  1,
+		})
+		if err != nil || len(mtc) == 0 {
+			return err
+		}
+		last = mtc[0].ID + 1
+	}
+	for last > 0 {
+		start := last - int64(limit)
+		n := limit
+		if start < 0 {
+			start = 0
+			n = int(last)
+		}
+		if err := p.wait(); err != nil {
+			return err
+		}
+		mtc, err := p.Binance.HistoricalTrades(HistoricalTradesRequest{
+			Symbol: symbol,
+			Limit:  n,
+			FromID: &start,
+		})
+		if err != nil {
+			return err
+		}
+		prev := last
+		for i := len(mtc) - 1; i >= 0; i-- {
+			if mtc[i].ID >= last {
+				continue
+			}
+			last = mtc[i].ID
+			if err := fn(mtc[i]); err != nil {
+				return stopIteration(err)
+			}
+		}
+		if last == prev {
+			return nil
+		}
+	}
+	return nil
+}
+
+// AllOrdersIter passes orders created between from and to to fn.
+func (p *Paginator) AllOrdersIter(symbol string, from, to time.Time, fn func(*ExecutedOrder) error) error {
+	fromID, ok, err := p.firstID(from, to, historyWindow, func(start, end time.Time) (int64, bool, error) {
+		eoc, err := p.Binance.AllOrders(AllOrdersRequest{
+			Symbol:    symbol,
+			StartTime: start,
+			EndTime:   end,
+			Limit:     1,
+			Timestamp: time.Now(),
+		})
+		if err != nil || len(eoc) == 0 {
+			return 0, false, err
+		}
+		return int64(eoc[0].OrderID), true, nil
+	})
+	if err != nil || !ok {
+		return err
+	}
+	return p.allOrders(symbol, fromID, to, fn)
+}
+
+// AllOrdersIterFromID passes orders starting with ID fromID to fn until the
+// most recent one.
+func (p *Paginator) AllOrdersIterFromID(symbol string, fromID int64, fn func(*ExecutedOrder) error) error {
+	return p.allOrders(symbol, fromID, time.Time{}, fn)
+}
+
+func (p *Paginator) allOrders(symbol string, fromID int64, to time.Time, fn func(*ExecutedOrder) error) error {
+	limit := p.pageSize()
+	last := fromID - 1
+	for {
+		if err := p.wait(); err != nil {
+			return err
+		}
+		eoc, err := p.Binance.AllOrders(AllOrdersRequest{
+			Symbol:    symbol,
+			OrderID:   last + 1,
+			Limit:     limit,
+			Timestamp: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		prev := last
+		for _, eo := range eoc {
+			if int64(eo.OrderID) <= last {
+				continue
+			}
+			if !to.IsZero() && eo.Time.After(to) {
+				return nil
+			}
+			last = int64(eo.OrderID)
+			if err := fn(eo); err != nil {
+				return stopIteration(err)
+			}
+		}
+		if len(eoc) < limit || last == prev {
+			return nil
+		}
+	}
+}
+
+// MyTradesIter passes user's trades executed between from and to to fn.
+func (p *Paginator) MyTradesIter(symbol string, from, to time.Time, fn func(*Trade) error) error {
+	fromID, ok, err := p.firstID(from, to, historyWindow, func(start, end time.Time) (int64, bool, error) {
+		tc, err := p.Binance.MyTrades(MyTradesRequest{
+			Symbol:    symbol,
+			StartTime: start,
+			EndTime:   end,
+			Limit:     1,
+			Timestamp: time.Now(),
+		})
+		if err != nil || len(tc) == 0 {
+			return 0, false, err
+		}
+		return tc[0].ID, true, nil
+	})
+	if err != nil || !ok {
+		return err
+	}
+	return p.myTrades(symbol, fromID, to, fn)
+}
+
+// MyTradesIterFromID passes user's trades starting with ID fromID to fn until
+// the most recent one.
+func (p *Paginator) MyTradesIterFromID(symbol string, fromID int64, fn func(*Trade) error) error {
+	return p.myTrades(symbol, fromID, time.Time{}, fn)
+}
+
+func (p *Paginator) myTrades(symbol string, fromID int64, to time.Time, fn func(*Trade) error) error {
+	limit := p.pageSize()
+	last := fromID - 1
+	for {
+		if err := p.wait(); err != nil {
+			return err
+		}
+		tc, err := p.Binance.MyTrades(MyTradesRequest{
+			Symbol:    symbol,
+			FromID:    last + 1,
+			Limit:     limit,
+			Timestamp: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		prev := last
+		for _, t := range tc {
+			if t.ID <= last {
+				continue
+			}
+			if !to.IsZero() && t.Time.After(to) {
+				return nil
+			}
+			last = t.ID
+			if err := fn(t); err != nil {
+				return stopIteration(err)
+			}
+		}
+		if len(tc) < limit || last == prev {
+			return nil
+		}
+	}
+}
+
+// AssetDividendsIter passes dividends of asset paid between from and to to fn,
+// dividends of all assets are passed when asset is empty.
+//
+// Like HistoricalTradesIter, dividends are passed in descending order, as
+// exchange returns them newest first.
+func (p *Paginator) AssetDividendsIter(asset string, from, to time.Time, fn func(*AssetDividend) error) error {
+	limit := p.pageSize()
+	if limit > dividendLimit {
+		limit = dividendLimit
+	}
+	seen := make(map[int64]bool)
+	end := to
+	for !end.Before(from) {
+		start := end.Add(-dividendWindow + time.Millisecond)
+		if start.Before(from) {
+			start = from
+		}
+		if err := p.wait(); err != nil {
+			return err
+		}
+		adh, err := p.Binance.AssetDividendRecord(AssetDividendRequest{
+			Asset:     asset,
+			StartTime: start,
+			EndTime:   end,
+			Limit:     limit,
+			Timestamp: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		oldest := end
+		passed := 0
+		for _, d := range adh.Dividends {
+			if d.DivTime.Before(oldest) {
+				oldest = d.DivTime
+			}
+			if seen[d.ID] {
+				continue
+			}
+			seen[d.ID] = true
+			passed++
+			if err := fn(d); err != nil {
+				return stopIteration(err)
+			}
+		}
+		switch {
+		case len(adh.Dividends) < limit:
+			end = start.Add(-time.Millisecond)
+		case passed == 0:
+			// whole page paid in the same millisecond was already passed
+			end = oldest.Add(-time.Millisecond)
+		default:
+			// rows paid at oldest may continue on the next page
+			end = oldest
+		}
+	}
+	return nil
+}
+
+// firstID scans [from, to] window by window and returns ID of the first row
+// found. API limits time range of ID-based endpoints, so the range cannot be
+// requested at once.
+func (p *Paginator) firstID(from, to time.Time, window time.Duration,
+	find func(start, end time.Time) (int64, bool, error)) (int64, bool, error) {
+	for start := from; !start.After(to); start = start.Add(window) {
+		end := start.Add(window - time.Millisecond)
+		if end.After(to) {
+			end = to
+		}
+		if err := p.wait(); err != nil {
+			return 0, false, err
+		}
+		id, ok, err := find(start, end)
+		if err != nil || ok {
+			return id, ok, err
+		}
+	}
+	return 0, false, nil
+}
+
+func (p *Paginator) pageSize() int {
+	if p.PageSize <= 0 {
+		return defaultPageSize
+	}
+	return p.PageSize
+}
+
+func (p *Paginator) wait() error {
+	ctx := p.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if p.Limiter == nil {
+		return nil
+	}
+	return p.Limiter.Wait(ctx)
+}
+
+func stopIteration(err error) error {
+	if err == ErrStopIteration {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,159 @@
+package binance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/binance-exchange/go-binance"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestKlinesIter(t *testing.T) {
+	binanceService := &ServiceMock{}
+	p := binance.NewPaginator(binance.NewBinance(binanceService), nil)
+	p.PageSize = 2
+
+	from := time.Unix(0, 0)
+	to := from.Add(4 * time.Minute)
+	kline := func(m int) *binance.Kline {
+		return &binance.Kline{OpenTime: from.Add(time.Duration(m) * time.Minute)}
+	}
+	page := func(start int64) interface{} {
+		return mock.MatchedBy(func(kr binance.KlinesRequest) bool {
+			return kr.StartTime == start && kr.Limit == 2
+		})
+	}
+	binanceService.On("Klines", page(0)).Return([]*binance.Kline{kline(0), kline(1)}, nil).Once()
+	// boundary row is repeated
+	binanceService.On("Klines", page(60001)).Return([]*binance.Kline{kline(1), kline(2)}, nil).Once()
+	binanceService.On("Klines", page(120001)).Return([]*binance.Kline{kline(3)}, nil).Once()
+
+	var kc []*binance.Kline
+	err := p.KlinesIter("BNBETH", binance.Minute, from, to, func(k *binance.Kline) error {
+		kc = append(kc, k)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []*binance.Kline{kline(0), kline(1), kline(2), kline(3)}, kc)
+	binanceService.AssertExpectations(t)
+}
+
+func TestMyTradesIter(t *testing.T) {
+	binanceService := &ServiceMock{}
+	p := binance.NewPaginator(binance.NewBinance(binanceService), nil)
+	p.PageSize = 2
+
+	from := time.Unix(0, 0)
+	to := from.Add(36 * time.Hour)
+	trade := func(id int64, h int) *binance.Trade {
+		return &binance.Trade{ID: id, Time: from.Add(time.Duration(h) * time.Hour)}
+	}
+	// no trades during first day
+	binanceService.On("MyTrades", mock.MatchedBy(func(mtr binance.MyTradesRequest) bool {
+		return mtr.StartTime.Equal(from) && mtr.Limit == 1
+	})).Return([]*binance.Trade{}, nil).Once()
+	binanceService.On("MyTrades", mock.MatchedBy(func(mtr binance.MyTradesRequest) bool {
+		return mtr.StartTime.Equal(from.Add(24*time.Hour)) && mtr.Limit == 1
+	})).Return([]*binance.Trade{trade(10, 25)}, nil).Once()
+	binanceService.On("MyTrades", mock.MatchedBy(func(mtr binance.MyTradesRequest) bool {
+		return mtr.FromID == 10 && mtr.StartTime.IsZero()
+	})).Return([]*binance.Trade{trade(10, 25), trade(11, 30)}, nil).Once()
+	binanceService.On("MyTrades", mock.MatchedBy(func(mtr binance.MyTradesRequest) bool {
+		return mtr.FromID == 12
+	})).Return([]*binance.Trade{trade(12, 35), trade(13, 40)}, nil).Once()
+
+	var ids []int64
+	err := p.MyTradesIter("BNBETH", from, to, func(t *binance.Trade) error {
+		ids = append(ids, t.ID)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{10, 11, 12}, ids)
+	binanceService.AssertExpectations(t)
+}
+
+func TestAggTradesIterFromIDStop(t *testing.T) {
+	binanceService := &ServiceMock{}
+	p := binance.NewPaginator(binance.NewBinance(binanceService), nil)
+	p.PageSize = 2
+
+	binanceService.On("AggTrades", mock.MatchedBy(func(atr binance.AggTradesRequest) bool {
+		return atr.FromID == 5
+	})).Return([]*binance.AggTrade{{ID: 5}, {ID: 6}}, nil).Once()
+
+	var ids []int
+	err := p.AggTradesIterFromID("BNBETH", 5, func(at *binance.AggTrade) error {
+		ids = append(ids, at.ID)
+		return binance.ErrStopIteration
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{5}, ids)
+	binanceService.AssertExpectations(t)
+}
+
+func TestHistoricalTradesIter(t *testing.T) {
+	binanceService := &ServiceMock{}
+	p := binance.NewPaginator(binance.NewBinance(binanceService), nil)
+	p.PageSize = 2
+
+	fromID := func(id int64, limit int) interface{} {
+		return mock.MatchedBy(func(htr binance.HistoricalTradesRequest) bool {
+			return htr.FromID != nil && *htr.FromID == id && htr.Limit == limit
+		})
+	}
+	binanceService.On("HistoricalTrades", mock.MatchedBy(func(htr binance.HistoricalTradesRequest) bool {
+		return htr.FromID == nil
+	})).Return([]*binance.MarketTrade{{ID: 4}}, nil).Once()
+	binanceService.On("HistoricalTrades", fromID(3, 2)).Return([]*binance.MarketTrade{{ID: 3}, {ID: 4}}, nil).Once()
+	binanceService.On("HistoricalTrades", fromID(1, 2)).Return([]*binance.MarketTrade{{ID: 1}, {ID: 2}}, nil).Once()
+	binanceService.On("HistoricalTrades", fromID(0, 1)).Return([]*binance.MarketTrade{{ID: 0}}, nil).Once()
+
+	var ids []int64
+	err := p.HistoricalTradesIter("BNBETH", -1, func(mt *binance.MarketTrade) error {
+		ids = append(ids, mt.ID)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{4, 3, 2, 1, 0}, ids)
+	binanceService.AssertExpectations(t)
+}
+
+func TestAssetDividendsIter(t *testing.T) {
+	binanceService := &ServiceMock{}
+	p := binance.NewPaginator(binance.NewBinance(binanceService), nil)
+	p.PageSize = 2
+
+	from := time.Unix(0, 0)
+	to := from.Add(time.Hour)
+	dividend := func(id int64, m int) *binance.AssetDividend {
+		return &binance.AssetDividend{ID: id, Asset: "BHFT", DivTime: from.Add(time.Duration(m) * time.Minute)}
+	}
+	page := func(end time.Time) interface{} {
+		return mock.MatchedBy(func(adr binance.AssetDividendRequest) bool {
+			return adr.EndTime.Equal(end) && adr.StartTime.Equal(from) && adr.Limit == 2
+		})
+	}
+	binanceService.On("AssetDividendRecord", page(to)).Return(&binance.AssetDividendHistory{
+		Total:     4,
+		Dividends: []*binance.AssetDividend{dividend(4, 50), dividend(3, 30)},
+	}, nil).Once()
+	// dividend paid at the page boundary is repeated
+	binanceService.On("AssetDividendRecord", page(from.Add(30*time.Minute))).Return(&binance.AssetDividendHistory{
+		Total:     4,
+		Dividends: []*binance.AssetDividend{dividend(3, 30), dividend(2, 10)},
+	}, nil).Once()
+	binanceService.On("AssetDividendRecord", page(from.Add(10*time.Minute))).Return(&binance.AssetDividendHistory{
+		Total:     4,
+		Dividends: []*binance.AssetDividend{dividend(2, 10)},
+	}, nil).Once()
+
+	var ids []int64
+	err := p.AssetDividendsIter("BHFT", from, to, func(d *binance.AssetDividend) error {
+		ids = append(ids, d.ID)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{4, 3, 2}, ids)
+	binanceService.AssertExpectations(t)
+}
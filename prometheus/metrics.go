@@ -0,0 +1,95 @@
+// Package prometheus provides Prometheus backend of binance.Metrics.
+//
+// It is a separate package, so that the client itself doesn't depend on
+// Prometheus client library. Importers provide
+// github.com/prometheus/client_golang in their own dependencies.
+package prometheus
+
+import (
+	"github.com/binance-exchange/go-binance"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/pkg/errors"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// wsLagBuckets are buckets of websocket lag histogram in seconds.
+var wsLagBuckets = []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5}
+
+// NewMetrics returns Metrics with all metrics registered in reg, so that they
+// are exposed by existing /metrics handler of the app. Default registerer is
+// used when reg is nil.
+//
+// Metrics are prefixed by binance_. Weight limit is not known until set by
+// SetWeightLimit.
+func NewMetrics(reg stdprometheus.Registerer) (*binance.Metrics, error) {
+	if reg == nil {
+		reg = stdprometheus.DefaultRegisterer
+	}
+	requests := stdprometheus.NewCounterVec(stdprometheus.CounterOpts{
+		Namespace: "binance",
+		Name:      "requests_total",
+		Help:      "REST calls by endpoint, method and HTTP status.",
+	}, binance.RequestLabels)
+	duration := stdprometheus.NewHistogramVec(stdprometheus.HistogramOpts{
+		Namespace: "binance",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of REST calls.",
+		Buckets:   stdprometheus.DefBuckets,
+	}, binance.RequestLabels)
+	errs := stdprometheus.NewCounterVec(stdprometheus.CounterOpts{
+		Namespace: "binance",
+		Name:      "errors_total",
+		Help:      "Error codes returned by exchange.",
+	}, binance.ErrorLabels)
+	usedWeight := stdprometheus.NewGaugeVec(stdprometheus.GaugeOpts{
+		Namespace: "binance",
+		Name:      "used_weight",
+		Help:      "Request weight used in the current minute.",
+	}, binance.WeightLabels)
+	weightLimit := stdprometheus.NewGaugeVec(stdprometheus.GaugeOpts{
+		Namespace: "binance",
+		Name:      "weight_limit",
+		Help:      "Request weight limit per minute.",
+	}, binance.WeightLabels)
+	wsConnected := stdprometheus.NewGaugeVec(stdprometheus.GaugeOpts{
+		Namespace: "binance",
+		Name:      "ws_connections",
+		Help:      "Open websocket connections by stream.",
+	}, binance.StreamLabels)
+	wsReconnects := stdprometheus.NewCounterVec(stdprometheus.CounterOpts{
+		Namespace: "binance",
+		Name:      "ws_reconnects_total",
+		Help:      "Reconnections of websocket streams.",
+	}, binance.StreamLabels)
+	wsMessages := stdprometheus.NewCounterVec(stdprometheus.CounterOpts{
+		Namespace: "binance",
+		Name:      "ws_messages_total",
+		Help:      "Messages received by stream.",
+	}, binance.StreamLabels)
+	wsLag := stdprometheus.NewHistogramVec(stdprometheus.HistogramOpts{
+		Namespace: "binance",
+		Name:      "ws_lag_seconds",
+		Help:      "Time between event time and receive time of websocket messages.",
+		Buckets:   wsLagBuckets,
+	}, binance.StreamLabels)
+
+	for _, c := range []stdprometheus.Collector{
+		requests, duration, errs, usedWeight, weightLimit,
+		wsConnected, wsReconnects, wsMessages, wsLag,
+	} {
+		if err := reg.Register(c); err != nil {
+			return nil, errors.Wrap(err, "unable to register metric")
+		}
+	}
+	return &binance.Metrics{
+		Requests:        kitprometheus.NewCounter(requests),
+		RequestDuration: kitprometheus.NewHistogram(duration),
+		Errors:          kitprometheus.NewCounter(errs),
+		UsedWeight:      kitprometheus.NewGauge(usedWeight),
+		WeightLimit:     kitprometheus.NewGauge(weightLimit),
+		WSConnected:     kitprometheus.NewGauge(wsConnected),
+		WSReconnects:    kitprometheus.NewCounter(wsReconnects),
+		WSMessages:      kitprometheus.NewCounter(wsMessages),
+		WSLag:           kitprometheus.NewHistogram(wsLag),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package prometheus
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/binance-exchange/go-binance"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+func TestNewMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-MBX-USED-WEIGHT-1M", "17")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	reg := stdprometheus.NewRegistry()
+	m, err := NewMetrics(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.SetWeightLimit("api", 6000)
+	as := binance.NewAPIService(server.URL, "key", nil, nil, nil, m.Middleware())
+	if err := as.Ping(); err != nil {
+		t.Fatal(err)
+	}
+
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := make(map[string]float64)
+	for _, mf := range mfs {
+		for _, metric := range mf.GetMetric() {
+			switch {
+			case metric.Counter != nil:
+				values[mf.GetName()] = metric.Counter.GetValue()
+			case metric.Gauge != nil:
+				values[mf.GetName()] = metric.Gauge.GetValue()
+			case metric.Histogram != nil:
+				values[mf.GetName()] = float64(metric.Histogram.GetSampleCount())
+			}
+		}
+	}
+	for name, want := range map[string]float64{
+		"binance_requests_total":           1,
+		"binance_request_duration_seconds": 1,
+		"binance_used_weight":              17,
+		"binance_weight_limit":             6000,
+	} {
+		if values[name] != want {
+			t.Errorf("%s = %v, want %v", name, values[name], want)
+		}
+	}
+
+	if _, err := NewMetrics(reg); err == nil {
+		t.Error("expected error when metrics are registered twice")
+	}
+}
@@ -0,0 +1,73 @@
+package binance
+
+import (
+	"net/url"
+	"strings"
+)
+
+const redacted = "[redacted]"
+
+// sensitiveParams lists parameters redacted from logs.
+var sensitiveParams = map[string]bool{
+	"signature": true,
+	"listenKey": true,
+}
+
+// redactSecret keeps first 4 characters of secret so that keys can be told
+// apart in logs.
+func redactSecret(secret string) string {
+	if len(secret) <= 8 {
+		return redacted
+	}
+	return secret[:4] + redacted
+}
+
+// redactParams redacts values of sensitive parameters in encoded query string
+// or form body, the rest is kept as it is.
+func redactParams(params string) string {
+	if params == "" {
+		return params
+	}
+	pairs := strings.Split(params, "&")
+	for i, pair := range pairs {
+		key := pair
+		if j := strings.IndexByte(pair, '='); j >= 0 {
+			key = pair[:j]
+		}
+		if sensitiveParams[key] {
+			pairs[i] = key + "=" + redacted
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// redactURL redacts sensitive query parameters and listen key path of
+// user data stream URL.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return redacted
+	}
+	u.RawQuery = redactParams(u.RawQuery)
+	if u.Scheme == "wss" || u.Scheme == "ws" {
+		// listen key is the last path segment of user data stream
+		if i := strings.LastIndexByte(u.Path, '/'); i >= 0 && !strings.Contains(u.Path[i:], "@") {
+			u.Path = u.Path[:i+1] + redactSecret(u.Path[i+1:])
+			u.RawPath = u.Path
+		}
+	}
+	return u.String()
+}
+
+// redactError removes sensitive parameters from URL of transport errors,
+// which include full request URL.
+func redactError(err error) error {
+	if urlErr, ok := err.(*url.Error); ok {
+		return &url.Error{
+			Op:  urlErr.Op,
+			URL: redactURL(urlErr.URL),
+			Err: urlErr.Err,
+		}
+	}
+	return err
+}
@@ -0,0 +1,66 @@
+package binance
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRedactParams(t *testing.T) {
+	tests := []struct {
+		params string
+		want   string
+	}{
+		{"", ""},
+		{"symbol=LTCBTC&timestamp=1", "symbol=LTCBTC&timestamp=1"},
+		{"listenKey=pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1", "listenKey=[redacted]"},
+		{"symbol=LTCBTC&signature=c8db56825ae7&timestamp=1", "symbol=LTCBTC&signature=[redacted]&timestamp=1"},
+		{"flag&signature", "flag&signature=[redacted]"},
+	}
+	for _, tt := range tests {
+		if got := redactParams(tt.params); got != tt.want {
+			t.Errorf("redactParams(%q) = %q, want %q", tt.params, got, tt.want)
+		}
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{
+			"https://api.binance.com/api/v3/account?timestamp=1&signature=abcdef",
+			"https://api.binance.com/api/v3/account?timestamp=1&signature=[redacted]",
+		},
+		{
+			"wss://stream.binance.com:9443/ws/pqia91ma19a5s61cv6a81va65sdf19v8a65a1",
+			"wss://stream.binance.com:9443/ws/pqia[redacted]",
+		},
+		{
+			"wss://stream.binance.com:9443/ws/ltcbtc@depth",
+			"wss://stream.binance.com:9443/ws/ltcbtc@depth",
+		},
+	}
+	for _, tt := range tests {
+		if got := redactURL(tt.url); got != tt.want {
+			t.Errorf("redactURL(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestRedactError(t *testing.T) {
+	err := redactError(&url.Error{
+		Op:  "Get",
+		URL: "https://api.binance.com/api/v3/account?timestamp=1&signature=abcdef",
+		Err: errors.New("connection refused"),
+	})
+	if strings.Contains(err.Error(), "abcdef") {
+		t.Errorf("signature not redacted: %v", err)
+	}
+	plain := errors.New("plain")
+	if redactError(plain) != plain {
+		t.Error("expected other errors to be returned as they are")
+	}
+}
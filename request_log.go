@@ -0,0 +1,72 @@
+package binance
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// weightHeaders are headers reporting request weight used in the current
+// minute, api endpoints report X-MBX-*, sapi endpoints X-SAPI-*.
+var weightHeaders = []string{
+	"X-MBX-USED-WEIGHT-1M",
+	"X-SAPI-USED-IP-WEIGHT-1M",
+	"X-SAPI-USED-UID-WEIGHT-1M",
+}
+
+// requestLog returns key-value pairs of per-request log line. Only endpoint
+// path is logged, query and body are left out as they carry signature and
+// listen key.
+func requestLog(method, endpoint string, resp *http.Response, latency time.Duration, err error) []interface{} {
+	keyvals := []interface{}{
+		"msg", "request",
+		"method", method,
+		"endpoint", endpoint,
+		"latency", latency,
+	}
+	if err != nil {
+		return append(keyvals, "err", err)
+	}
+	keyvals = append(keyvals, "status", resp.StatusCode)
+	if weight := usedWeight(resp.Header); weight != "" {
+		keyvals = append(keyvals, "weight", weight)
+	}
+	if resp.StatusCode != 200 {
+		if code, ok := peekErrorCode(resp); ok {
+			keyvals = append(keyvals, "errorCode", code)
+		}
+	}
+	return keyvals
+}
+
+func usedWeight(h http.Header) string {
+	for _, name := range weightHeaders {
+		if w := h.Get(name); w != "" {
+			return w
+		}
+	}
+	return ""
+}
+
+// peekErrorCode reads error code from response body, body is restored so
+// that it can be read again by caller.
+func peekErrorCode(resp *http.Response) (int, bool) {
+	if resp.Body == nil {
+		return 0, false
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return 0, false
+	}
+	rawErr := struct {
+		Code *int `json:"code"`
+	}{}
+	if err := json.Unmarshal(body, &rawErr); err != nil || rawErr.Code == nil {
+		return 0, false
+	}
+	return *rawErr.Code, true
+}
@@ -0,0 +1,69 @@
+package binance
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+)
+
+func TestRequestLog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-MBX-USED-WEIGHT-1M", "42")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":-1125,"msg":"This listenKey does not exist."}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := log.NewLogfmtLogger(&buf)
+	listenKey := "pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"
+	as := NewAPIService(server.URL, "vmPUZE6mv9SD5VNHk4HlWFsOr6aKE2zvsw0MuIgwCIPy6utIco14y7Ju91duEh8A",
+		&HmacSigner{Key: []byte("secret")}, logger, context.Background())
+
+	err := as.KeepAliveUserDataStream(&Stream{ListenKey: listenKey})
+	if apiErr, ok := apiError(err); !ok || apiErr.Code != -1125 {
+		t.Fatalf("expected API error -1125, got %v", err)
+	}
+
+	out := buf.String()
+	if strings.Contains(out, listenKey) || strings.Contains(out, "vmPUZE6mv9SD5VNHk4HlWFsOr6aKE2zvsw0MuIgwCIPy6utIco14y7Ju91duEh8A") {
+		t.Errorf("secrets not redacted:\n%s", out)
+	}
+	for _, want := range []string{
+		"msg=request",
+		"method=PUT",
+		"endpoint=api/v1/userDataStream",
+		"status=400",
+		"weight=42",
+		"errorCode=-1125",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in log:\n%s", want, out)
+		}
+	}
+}
+
+func TestRequestLogTransportError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	var buf bytes.Buffer
+	signer := &HmacSigner{Key: []byte("secret")}
+	as := NewAPIService(server.URL, "key", signer, log.NewLogfmtLogger(&buf), context.Background()).(*apiService)
+	_, err := as.request("GET", "api/v3/account", map[string]string{"timestamp": "1"}, true, true)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	signature := signer.Sign([]byte("timestamp=1"))
+	if strings.Contains(err.Error(), signature) || strings.Contains(buf.String(), signature) {
+		t.Errorf("signature not redacted: %v\n%s", err, buf.String())
+	}
+	if !strings.Contains(buf.String(), "endpoint=api/v3/account") {
+		t.Errorf("expected request log line:\n%s", buf.String())
+	}
+}
@@ -62,7 +62,7 @@ func (as *apiService) NewOrder(or NewOrderRequest) (*ProcessedOrder, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawOrder := struct {
@@ -138,7 +138,7 @@ func (as *apiService) NewOrderTest(or NewOrderRequest) error {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return as.handleError(textRes)
+		return as.handleError(res.StatusCode, textRes)
 	}
 	return nil
 }
@@ -168,7 +168,7 @@ func (as *apiService) QueryOrder(qor QueryOrderRequest) (*ExecutedOrder, error)
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawOrder := &rawExecutedOrder{}
@@ -211,7 +211,7 @@ func (as *apiService) CancelOrder(cor CancelOrderRequest) (*CanceledOrder, error
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawCanceledOrder := struct {
@@ -251,7 +251,7 @@ func (as *apiService) OpenOrders(oor OpenOrdersRequest) ([]*ExecutedOrder, error
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawOrders := []*rawExecutedOrder{}
@@ -278,6 +278,12 @@ func (as *apiService) AllOrders(aor AllOrdersRequest) ([]*ExecutedOrder, error)
 	if aor.OrderID != 0 {
 		params["orderId"] = strconv.FormatInt(aor.OrderID, 10)
 	}
+	if !aor.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(aor.StartTime), 10)
+	}
+	if !aor.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(aor.EndTime), 10)
+	}
 	if aor.Limit != 0 {
 		params["limit"] = strconv.Itoa(aor.Limit)
 	}
@@ -296,7 +302,7 @@ func (as *apiService) AllOrders(aor AllOrdersRequest) ([]*ExecutedOrder, error)
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawOrders := []*rawExecutedOrder{}
@@ -334,17 +340,22 @@ func (as *apiService) Account(ar AccountRequest) (*Account, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
+	return accountFromRaw(textRes)
+}
+
+func accountFromRaw(textRes []byte) (*Account, error) {
 	rawAccount := struct {
-		MakerCommision   int64 `json:"makerCommision"`
-		TakerCommission  int64 `json:"takerCommission"`
-		BuyerCommission  int64 `json:"buyerCommission"`
-		SellerCommission int64 `json:"sellerCommission"`
-		CanTrade         bool  `json:"canTrade"`
-		CanWithdraw      bool  `json:"canWithdraw"`
-		CanDeposit       bool  `json:"canDeposit"`
+		MakerCommision   int64               `json:"makerCommission"`
+		TakerCommission  int64               `json:"takerCommission"`
+		BuyerCommission  int64               `json:"buyerCommission"`
+		SellerCommission int64               `json:"sellerCommission"`
+		CommissionRates  *rawCommissionRates `json:"commissionRates"`
+		CanTrade         bool                `json:"canTrade"`
+		CanWithdraw      bool                `json:"canWithdraw"`
+		CanDeposit       bool                `json:"canDeposit"`
 		Balances         []struct {
 			Asset  string `json:"asset"`
 			Free   string `json:"free"`
@@ -364,6 +375,13 @@ func (as *apiService) Account(ar AccountRequest) (*Account, error) {
 		CanWithdraw:     rawAccount.CanWithdraw,
 		CanDeposit:      rawAccount.CanDeposit,
 	}
+	if rawAccount.CommissionRates != nil {
+		cr, err := rawAccount.CommissionRates.commissionRates()
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse Account.CommissionRates")
+		}
+		acc.CommissionRates = cr
+	}
 	for _, b := range rawAccount.Balances {
 		f, err := floatFromString(b.Free)
 		if err != nil {
@@ -391,7 +409,13 @@ func (as *apiService) MyTrades(mtr MyTradesRequest) ([]*Trade, error) {
 		params["recvWindow"] = strconv.FormatInt(recvWindow(mtr.RecvWindow), 10)
 	}
 	if mtr.FromID != 0 {
-		params["orderId"] = strconv.FormatInt(mtr.FromID, 10)
+		params["fromId"] = strconv.FormatInt(mtr.FromID, 10)
+	}
+	if !mtr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(mtr.StartTime), 10)
+	}
+	if !mtr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(mtr.EndTime), 10)
 	}
 	if mtr.Limit != 0 {
 		params["limit"] = strconv.Itoa(mtr.Limit)
@@ -408,7 +432,7 @@ func (as *apiService) MyTrades(mtr MyTradesRequest) ([]*Trade, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawTrades := []struct {
@@ -459,175 +483,6 @@ func (as *apiService) MyTrades(mtr MyTradesRequest) ([]*Trade, error) {
 	return tc, nil
 }
 
-func (as *apiService) Withdraw(wr WithdrawRequest) (*WithdrawResult, error) {
-	params := make(map[string]string)
-	params["asset"] = wr.Asset
-	params["address"] = wr.Address
-	params["amount"] = strconv.FormatFloat(wr.Amount, 'f', 10, 64)
-	params["timestamp"] = strconv.FormatInt(unixMillis(wr.Timestamp), 10)
-	if wr.RecvWindow != 0 {
-		params["recvWindow"] = strconv.FormatInt(recvWindow(wr.RecvWindow), 10)
-	}
-	if wr.Name != "" {
-		params["name"] = wr.Name
-	}
-
-	res, err := as.request("POST", "wapi/v1/withdraw.html", params, true, true)
-	if err != nil {
-		return nil, err
-	}
-	textRes, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to read response from withdraw.post")
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
-	}
-
-	rawResult := struct {
-		Msg     string `json:"msg"`
-		Success bool   `json:"success"`
-	}{}
-	if err := json.Unmarshal(textRes, &rawResult); err != nil {
-		return nil, errors.Wrap(err, "rawTrades unmarshal failed")
-	}
-
-	return &WithdrawResult{
-		Msg:     rawResult.Msg,
-		Success: rawResult.Success,
-	}, nil
-}
-func (as *apiService) DepositHistory(hr HistoryRequest) ([]*Deposit, error) {
-	params := make(map[string]string)
-	params["timestamp"] = strconv.FormatInt(unixMillis(hr.Timestamp), 10)
-	if hr.Asset != "" {
-		params["asset"] = hr.Asset
-	}
-	if hr.Status != nil {
-		params["status"] = strconv.Itoa(*hr.Status)
-	}
-	if !hr.StartTime.IsZero() {
-		params["startTime"] = strconv.FormatInt(unixMillis(hr.StartTime), 10)
-	}
-	if !hr.EndTime.IsZero() {
-		params["startTime"] = strconv.FormatInt(unixMillis(hr.EndTime), 10)
-	}
-	if hr.RecvWindow != 0 {
-		params["recvWindow"] = strconv.FormatInt(recvWindow(hr.RecvWindow), 10)
-	}
-
-	res, err := as.request("POST", "wapi/v1/getDepositHistory.html", params, true, true)
-	if err != nil {
-		return nil, err
-	}
-	textRes, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to read response from depositHistory.post")
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
-	}
-
-	rawDepositHistory := struct {
-		DepositList []struct {
-			InsertTime float64 `json:"insertTime"`
-			Amount     float64 `json:"amount"`
-			Asset      string  `json:"asset"`
-			Status     int     `json:"status"`
-		}
-		Success bool `json:"success"`
-	}{}
-	if err := json.Unmarshal(textRes, &rawDepositHistory); err != nil {
-		return nil, errors.Wrap(err, "rawDepositHistory unmarshal failed")
-	}
-
-	var dc []*Deposit
-	for _, d := range rawDepositHistory.DepositList {
-		t, err := timeFromUnixTimestampFloat(d.InsertTime)
-		if err != nil {
-			return nil, err
-		}
-		dc = append(dc, &Deposit{
-			InsertTime: t,
-			Amount:     d.Amount,
-			Asset:      d.Asset,
-			Status:     d.Status,
-		})
-	}
-
-	return dc, nil
-}
-func (as *apiService) WithdrawHistory(hr HistoryRequest) ([]*Withdrawal, error) {
-	params := make(map[string]string)
-	params["timestamp"] = strconv.FormatInt(unixMillis(hr.Timestamp), 10)
-	if hr.Asset != "" {
-		params["asset"] = hr.Asset
-	}
-	if hr.Status != nil {
-		params["status"] = strconv.Itoa(*hr.Status)
-	}
-	if !hr.StartTime.IsZero() {
-		params["startTime"] = strconv.FormatInt(unixMillis(hr.StartTime), 10)
-	}
-	if !hr.EndTime.IsZero() {
-		params["startTime"] = strconv.FormatInt(unixMillis(hr.EndTime), 10)
-	}
-	if hr.RecvWindow != 0 {
-		params["recvWindow"] = strconv.FormatInt(recvWindow(hr.RecvWindow), 10)
-	}
-
-	res, err := as.request("POST", "wapi/v1/getWithdrawHistory.html", params, true, true)
-	if err != nil {
-		return nil, err
-	}
-	textRes, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to read response from withdrawHistory.post")
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
-	}
-
-	rawWithdrawHistory := struct {
-		WithdrawList []struct {
-			Amount    float64 `json:"amount"`
-			Address   string  `json:"address"`
-			TxID      string  `json:"txId"`
-			Asset     string  `json:"asset"`
-			ApplyTime float64 `json:"insertTime"`
-			Status    int     `json:"status"`
-		}
-		Success bool `json:"success"`
-	}{}
-	if err := json.Unmarshal(textRes, &rawWithdrawHistory); err != nil {
-		return nil, errors.Wrap(err, "rawWithdrawHistory unmarshal failed")
-	}
-
-	var wc []*Withdrawal
-	for _, w := range rawWithdrawHistory.WithdrawList {
-		t, err := timeFromUnixTimestampFloat(w.ApplyTime)
-		if err != nil {
-			return nil, err
-		}
-		wc = append(wc, &Withdrawal{
-			Amount:    w.Amount,
-			Address:   w.Address,
-			TxID:      w.TxID,
-			Asset:     w.Asset,
-			ApplyTime: t,
-			Status:    w.Status,
-		})
-	}
-
-	return wc, nil
-}
-
 func executedOrderFromRaw(reo *rawExecutedOrder) (*ExecutedOrder, error) {
 	price, err := strconv.ParseFloat(reo.Price, 64)
 	if err != nil {
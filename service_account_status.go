@@ -0,0 +1,332 @@
+package binance
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func (as *apiService) AccountSnapshot(asr AccountSnapshotRequest) ([]*AccountSnapshot, error) {
+	params := make(map[string]string)
+	params["type"] = string(asr.Type)
+	params["timestamp"] = strconv.FormatInt(unixMillis(asr.Timestamp), 10)
+	if !asr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(asr.StartTime), 10)
+	}
+	if !asr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(asr.EndTime), 10)
+	}
+	if asr.Limit != 0 {
+		params["limit"] = strconv.Itoa(asr.Limit)
+	}
+	if asr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(asr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/accountSnapshot", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountSnapshotsFromRaw(textRes)
+}
+
+func accountSnapshotsFromRaw(textRes []byte) ([]*AccountSnapshot, error) {
+	rawSnapshots := struct {
+		Code        int    `json:"code"`
+		Msg         string `json:"msg"`
+		SnapshotVos []struct {
+			Type       string          `json:"type"`
+			UpdateTime float64         `json:"updateTime"`
+			Data       json.RawMessage `json:"data"`
+		} `json:"snapshotVos"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawSnapshots); err != nil {
+		return nil, errors.Wrap(err, "rawSnapshots unmarshal failed")
+	}
+	if rawSnapshots.Code != 200 {
+		return nil, &Error{Code: rawSnapshots.Code, Message: rawSnapshots.Msg}
+	}
+
+	var asc []*AccountSnapshot
+	for _, rs := range rawSnapshots.SnapshotVos {
+		t, err := timeFromUnixTimestampFloat(rs.UpdateTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse AccountSnapshot.UpdateTime")
+		}
+		snapshot := &AccountSnapshot{
+			Type:       SnapshotType(strings.ToUpper(rs.Type)),
+			UpdateTime: t,
+		}
+		switch snapshot.Type {
+		case SnapshotSpot:
+			snapshot.Spot, err = spotSnapshotFromRaw(rs.Data)
+		case SnapshotMargin:
+			snapshot.Margin, err = marginSnapshotFromRaw(rs.Data)
+		case SnapshotFutures:
+			snapshot.Futures, err = futuresSnapshotFromRaw(rs.Data)
+		}
+		if err != nil {
+			return nil, err
+		}
+		asc = append(asc, snapshot)
+	}
+	return asc, nil
+}
+
+func spotSnapshotFromRaw(data []byte) (*SpotSnapshot, error) {
+	rawData := struct {
+		TotalAssetOfBtc json.Number `json:"totalAssetOfBtc"`
+		Balances        []struct {
+			Asset  string      `json:"asset"`
+			Free   json.Number `json:"free"`
+			Locked json.Number `json:"locked"`
+		} `json:"balances"`
+	}{}
+	if err := json.Unmarshal(data, &rawData); err != nil {
+		return nil, errors.Wrap(err, "spot snapshot unmarshal failed")
+	}
+
+	totalAssetOfBtc, _ := rawData.TotalAssetOfBtc.Float64()
+	ss := &SpotSnapshot{
+		TotalAssetOfBtc: totalAssetOfBtc,
+	}
+	for _, b := range rawData.Balances {
+		free, _ := b.Free.Float64()
+		locked, _ := b.Locked.Float64()
+		ss.Balances = append(ss.Balances, &Balance{
+			Asset:  b.Asset,
+			Free:   free,
+			Locked: locked,
+		})
+	}
+	return ss, nil
+}
+
+func marginSnapshotFromRaw(data []byte) (*MarginSnapshot, error) {
+	rawData := struct {
+		MarginLevel         json.Number `json:"marginLevel"`
+		TotalAssetOfBtc     json.Number `json:"totalAssetOfBtc"`
+		TotalLiabilityOfBtc json.Number `json:"totalLiabilityOfBtc"`
+		TotalNetAssetOfBtc  json.Number `json:"totalNetAssetOfBtc"`
+		UserAssets          []struct {
+			Asset    string      `json:"asset"`
+			Borrowed json.Number `json:"borrowed"`
+			Free     json.Number `json:"free"`
+			Interest json.Number `json:"interest"`
+			Locked   json.Number `json:"locked"`
+			NetAsset json.Number `json:"netAsset"`
+		} `json:"userAssets"`
+	}{}
+	if err := json.Unmarshal(data, &rawData); err != nil {
+		return nil, errors.Wrap(err, "margin snapshot unmarshal failed")
+	}
+
+	marginLevel, _ := rawData.MarginLevel.Float64()
+	totalAssetOfBtc, _ := rawData.TotalAssetOfBtc.Float64()
+	totalLiabilityOfBtc, _ := rawData.TotalLiabilityOfBtc.Float64()
+	totalNetAssetOfBtc, _ := rawData.TotalNetAssetOfBtc.Float64()
+	ms := &MarginSnapshot{
+		MarginLevel:         marginLevel,
+		TotalAssetOfBtc:     totalAssetOfBtc,
+		TotalLiabilityOfBtc: totalLiabilityOfBtc,
+		TotalNetAssetOfBtc:  totalNetAssetOfBtc,
+	}
+	for _, a := range rawData.UserAssets {
+		borrowed, _ := a.Borrowed.Float64()
+		free, _ := a.Free.Float64()
+		interest, _ := a.Interest.Float64()
+		locked, _ := a.Locked.Float64()
+		netAsset, _ := a.NetAsset.Float64()
+		ms.Assets = append(ms.Assets, &Asset{
+			Asset:    a.Asset,
+			Borrowed: borrowed,
+			Free:     free,
+			Interest: interest,
+			Locked:   locked,
+			NetAsset: netAsset,
+		})
+	}
+	return ms, nil
+}
+
+func futuresSnapshotFromRaw(data []byte) (*FuturesSnapshot, error) {
+	rawData := struct {
+		Assets []struct {
+			Asset         string      `json:"asset"`
+			MarginBalance json.Number `json:"marginBalance"`
+			WalletBalance json.Number `json:"walletBalance"`
+		} `json:"assets"`
+		Position []struct {
+			Symbol           string      `json:"symbol"`
+			EntryPrice       json.Number `json:"entryPrice"`
+			MarkPrice        json.Number `json:"markPrice"`
+			PositionAmt      json.Number `json:"positionAmt"`
+			UnRealizedProfit json.Number `json:"unRealizedProfit"`
+		} `json:"position"`
+	}{}
+	if err := json.Unmarshal(data, &rawData); err != nil {
+		return nil, errors.Wrap(err, "futures snapshot unmarshal failed")
+	}
+
+	fs := &FuturesSnapshot{}
+	for _, a := range rawData.Assets {
+		marginBalance, _ := a.MarginBalance.Float64()
+		walletBalance, _ := a.WalletBalance.Float64()
+		fs.Assets = append(fs.Assets, &FuturesBalance{
+			Asset:         a.Asset,
+			MarginBalance: marginBalance,
+			WalletBalance: walletBalance,
+		})
+	}
+	for _, p := range rawData.Position {
+		entryPrice, _ := p.EntryPrice.Float64()
+		markPrice, _ := p.MarkPrice.Float64()
+		positionAmt, _ := p.PositionAmt.Float64()
+		unrealizedProfit, _ := p.UnRealizedProfit.Float64()
+		fs.Positions = append(fs.Positions, &FuturesPosition{
+			Symbol:           p.Symbol,
+			EntryPrice:       entryPrice,
+			MarkPrice:        markPrice,
+			PositionAmt:      positionAmt,
+			UnrealizedProfit: unrealizedProfit,
+		})
+	}
+	return fs, nil
+}
+
+func (as *apiService) APIKeyPermission(ar AccountRequest) (*APIKeyPermission, error) {
+	textRes, err := as.accountStatusRequest("sapi/v1/account/apiRestrictions", ar)
+	if err != nil {
+		return nil, err
+	}
+
+	rawPermission := struct {
+		IPRestrict                     bool    `json:"ipRestrict"`
+		CreateTime                     float64 `json:"createTime"`
+		EnableReading                  bool    `json:"enableReading"`
+		EnableSpotAndMarginTrading     bool    `json:"enableSpotAndMarginTrading"`
+		EnableWithdrawals              bool    `json:"enableWithdrawals"`
+		EnableInternalTransfer         bool    `json:"enableInternalTransfer"`
+		EnableMargin                   bool    `json:"enableMargin"`
+		EnableFutures                  bool    `json:"enableFutures"`
+		EnableVanillaOptions           bool    `json:"enableVanillaOptions"`
+		EnablePortfolioMarginTrading   bool    `json:"enablePortfolioMarginTrading"`
+		PermitsUniversalTransfer       bool    `json:"permitsUniversalTransfer"`
+		TradingAuthorityExpirationTime float64 `json:"tradingAuthorityExpirationTime"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawPermission); err != nil {
+		return nil, errors.Wrap(err, "rawPermission unmarshal failed")
+	}
+
+	ct, err := timeFromUnixTimestampFloat(rawPermission.CreateTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse APIKeyPermission.CreateTime")
+	}
+	var et time.Time
+	if rawPermission.TradingAuthorityExpirationTime != 0 {
+		et, _ = timeFromUnixTimestampFloat(rawPermission.TradingAuthorityExpirationTime)
+	}
+	return &APIKeyPermission{
+		IPRestrict:                     rawPermission.IPRestrict,
+		CreateTime:                     ct,
+		EnableReading:                  rawPermission.EnableReading,
+		EnableSpotAndMarginTrading:     rawPermission.EnableSpotAndMarginTrading,
+		EnableWithdrawals:              rawPermission.EnableWithdrawals,
+		EnableInternalTransfer:         rawPermission.EnableInternalTransfer,
+		EnableMargin:                   rawPermission.EnableMargin,
+		EnableFutures:                  rawPermission.EnableFutures,
+		EnableVanillaOptions:           rawPermission.EnableVanillaOptions,
+		EnablePortfolioMarginTrading:   rawPermission.EnablePortfolioMarginTrading,
+		PermitsUniversalTransfer:       rawPermission.PermitsUniversalTransfer,
+		TradingAuthorityExpirationTime: et,
+	}, nil
+}
+
+func (as *apiService) AccountStatus(ar AccountRequest) (*AccountStatus, error) {
+	textRes, err := as.accountStatusRequest("sapi/v1/account/status", ar)
+	if err != nil {
+		return nil, err
+	}
+
+	rawStatus := struct {
+		Data string `json:"data"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawStatus); err != nil {
+		return nil, errors.Wrap(err, "rawStatus unmarshal failed")
+	}
+	return &AccountStatus{
+		Status: rawStatus.Data,
+	}, nil
+}
+
+func (as *apiService) APITradingStatus(ar AccountRequest) (*APITradingStatus, error) {
+	textRes, err := as.accountStatusRequest("sapi/v1/account/apiTradingStatus", ar)
+	if err != nil {
+		return nil, err
+	}
+	return apiTradingStatusFromRaw(textRes)
+}
+
+func apiTradingStatusFromRaw(textRes []byte) (*APITradingStatus, error) {
+	rawStatus := struct {
+		Data struct {
+			IsLocked           bool               `json:"isLocked"`
+			PlannedRecoverTime float64            `json:"plannedRecoverTime"`
+			TriggerCondition   map[string]float64 `json:"triggerCondition"`
+			Indicators         map[string][]struct {
+				I string  `json:"i"`
+				C int     `json:"c"`
+				V float64 `json:"v"`
+				T float64 `json:"t"`
+			} `json:"indicators"`
+			UpdateTime float64 `json:"updateTime"`
+		} `json:"data"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawStatus); err != nil {
+		return nil, errors.Wrap(err, "rawStatus unmarshal failed")
+	}
+
+	d := rawStatus.Data
+	ut, err := timeFromUnixTimestampFloat(d.UpdateTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse APITradingStatus.UpdateTime")
+	}
+	var rt time.Time
+	if d.PlannedRecoverTime != 0 {
+		rt, _ = timeFromUnixTimestampFloat(d.PlannedRecoverTime)
+	}
+	ats := &APITradingStatus{
+		IsLocked:           d.IsLocked,
+		PlannedRecoverTime: rt,
+		TriggerCondition:   d.TriggerCondition,
+		Indicators:         make(map[string][]*TradingIndicator),
+		UpdateTime:         ut,
+	}
+	for symbol, ric := range d.Indicators {
+		for _, ri := range ric {
+			ats.Indicators[symbol] = append(ats.Indicators[symbol], &TradingIndicator{
+				Name:         ri.I,
+				Count:        ri.C,
+				Value:        ri.V,
+				TriggerValue: ri.T,
+			})
+		}
+	}
+	return ats, nil
+}
+
+// accountStatusRequest sends signed GET request without parameters other than
+// timestamp and recvWindow and returns response body.
+func (as *apiService) accountStatusRequest(endpoint string, ar AccountRequest) ([]byte, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(ar.Timestamp), 10)
+	if ar.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(ar.RecvWindow), 10)
+	}
+
+	return as.signedRequest("GET", endpoint, params)
+}
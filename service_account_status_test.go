@@ -0,0 +1,56 @@
+package binance
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccountSnapshotsFromRaw(t *testing.T) {
+	textRes := []byte(`{"code":200,"msg":"","snapshotVos":[
+		{"data":{"balances":[{"asset":"BTC","free":"0.09905021","locked":"0.00000000"}],"totalAssetOfBtc":"0.09942700"},
+		"type":"spot","updateTime":1576281599000},
+		{"data":{"marginLevel":"2748.02909813","totalAssetOfBtc":"0.00274803","totalLiabilityOfBtc":"0.00000100",
+		"totalNetAssetOfBtc":"0.00274750","userAssets":[{"asset":"XRP","borrowed":"0.00000000","free":"1.00000000",
+		"interest":"0.00000000","locked":"0.00000000","netAsset":"1.00000000"}]},"type":"margin","updateTime":1576281599000},
+		{"data":{"assets":[{"asset":"USDT","marginBalance":"118.99782335","walletBalance":"120.23811389"}],
+		"position":[{"entryPrice":"7130.41000000","markPrice":"7257.66239673","positionAmt":"0.01000000",
+		"symbol":"BTCUSDT","unRealizedProfit":"1.24029054"}]},"type":"futures","updateTime":1576281599000}]}`)
+	asc, err := accountSnapshotsFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(asc) != 3 {
+		t.Fatalf("unexpected number of snapshots: %d", len(asc))
+	}
+	if s := asc[0]; s.Type != SnapshotSpot || s.Spot == nil || s.Spot.TotalAssetOfBtc != 0.099427 || s.Spot.Balances[0].Free != 0.09905021 {
+		t.Errorf("unexpected spot snapshot: %#v", s)
+	}
+	if !asc[0].UpdateTime.Equal(time.Unix(1576281599, 0)) {
+		t.Errorf("unexpected update time: %s", asc[0].UpdateTime)
+	}
+	if s := asc[1]; s.Type != SnapshotMargin || s.Margin == nil || s.Margin.MarginLevel != 2748.02909813 || s.Margin.Assets[0].Free != 1 {
+		t.Errorf("unexpected margin snapshot: %#v", s)
+	}
+	if s := asc[2]; s.Type != SnapshotFutures || s.Futures == nil || s.Futures.Positions[0].UnrealizedProfit != 1.24029054 ||
+		s.Futures.Assets[0].WalletBalance != 120.23811389 {
+		t.Errorf("unexpected futures snapshot: %#v", s)
+	}
+}
+
+func TestAPITradingStatusFromRaw(t *testing.T) {
+	textRes := []byte(`{"data":{"isLocked":false,"plannedRecoverTime":0,
+		"triggerCondition":{"GCR":150,"IFER":150,"UFR":300},
+		"indicators":{"BTCUSDT":[{"i":"UFR","c":20,"v":0.05,"t":0.995},{"i":"IFER","c":20,"v":0.99,"t":0.99}]},
+		"updateTime":1547630471725}}`)
+	ats, err := apiTradingStatusFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ats.IsLocked || !ats.PlannedRecoverTime.IsZero() || ats.TriggerCondition["UFR"] != 300 {
+		t.Errorf("unexpected status: %#v", ats)
+	}
+	ic := ats.Indicators["BTCUSDT"]
+	if len(ic) != 2 || ic[0].Name != "UFR" || ic[0].Count != 20 || ic[0].Value != 0.05 || ic[0].TriggerValue != 0.995 {
+		t.Errorf("unexpected indicators: %#v", ic)
+	}
+}
@@ -180,15 +180,17 @@ func TestWithdraw(t *testing.T) {
 	b := binance.NewBinance(binanceService)
 
 	wr := binance.WithdrawRequest{
-		Asset:      "ETH",
-		Address:    "0x1234",
-		Amount:     1.23,
-		Name:       "My wallet",
-		RecvWindow: 1 * time.Second,
-		Timestamp:  time.Now(),
+		Asset:           "ETH",
+		Network:         "ETH",
+		Address:         "0x1234",
+		Amount:          1.23,
+		WithdrawOrderID: "payout-1",
+		Name:            "My wallet",
+		RecvWindow:      1 * time.Second,
+		Timestamp:       time.Now(),
 	}
 	wres := &binance.WithdrawResult{
-		Success: true,
+		ID: "7213fea8e94b4a5593d507237e5a555b",
 	}
 
 	binanceService.On("Withdraw", wr).Return(wres, nil)
@@ -201,10 +203,13 @@ func TestDepositHistory(t *testing.T) {
 	binanceService := &ServiceMock{}
 	b := binance.NewBinance(binanceService)
 
-	hr := binance.HistoryRequest{
+	status := binance.DepositSuccess
+	hr := binance.DepositHistoryRequest{
 		Asset:      "ETH",
+		Status:     &status,
 		StartTime:  time.Now().Add(-1 * time.Hour),
 		EndTime:    time.Now(),
+		Limit:      100,
 		RecvWindow: 1 * time.Second,
 		Timestamp:  time.Now(),
 	}
@@ -220,10 +225,14 @@ func TestWithdrawHistory(t *testing.T) {
 	binanceService := &ServiceMock{}
 	b := binance.NewBinance(binanceService)
 
-	hr := binance.HistoryRequest{
+	status := binance.WithdrawCompleted
+	hr := binance.WithdrawHistoryRequest{
 		Asset:      "ETH",
+		Status:     &status,
 		StartTime:  time.Now().Add(-1 * time.Hour),
 		EndTime:    time.Now(),
+		Offset:     100,
+		Limit:      100,
 		RecvWindow: 1 * time.Second,
 		Timestamp:  time.Now(),
 	}
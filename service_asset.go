@@ -0,0 +1,333 @@
+package binance
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+type rawDribbletDetail struct {
+	TranID              int64       `json:"tranId"`
+	TransID             int64       `json:"transId"`
+	FromAsset           string      `json:"fromAsset"`
+	Amount              json.Number `json:"amount"`
+	TransferedAmount    json.Number `json:"transferedAmount"`
+	ServiceChargeAmount json.Number `json:"serviceChargeAmount"`
+	OperateTime         float64     `json:"operateTime"`
+}
+
+func (rdd *rawDribbletDetail) dribbletDetail() (*DribbletDetail, error) {
+	t, err := timeFromUnixTimestampFloat(rdd.OperateTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse DribbletDetail.OperateTime")
+	}
+	amount, _ := rdd.Amount.Float64()
+	transfered, _ := rdd.TransferedAmount.Float64()
+	serviceCharge, _ := rdd.ServiceChargeAmount.Float64()
+	// dust log calls transaction ID transId, dust transfer tranId
+	tranID := rdd.TranID
+	if tranID == 0 {
+		tranID = rdd.TransID
+	}
+	return &DribbletDetail{
+		TranID:              tranID,
+		FromAsset:           rdd.FromAsset,
+		Amount:              amount,
+		TransferedAmount:    transfered,
+		ServiceChargeAmount: serviceCharge,
+		OperateTime:         t,
+	}, nil
+}
+
+func (as *apiService) DustLog(dlr DustLogRequest) (*DustLog, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(dlr.Timestamp), 10)
+	if !dlr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(dlr.StartTime), 10)
+	}
+	if !dlr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(dlr.EndTime), 10)
+	}
+	if dlr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(dlr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/asset/dribblet", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return dustLogFromRaw(textRes)
+}
+
+func dustLogFromRaw(textRes []byte) (*DustLog, error) {
+	rawLog := struct {
+		Total     int `json:"total"`
+		Dribblets []struct {
+			TransID                  int64               `json:"transId"`
+			OperateTime              float64             `json:"operateTime"`
+			TotalTransferedAmount    json.Number         `json:"totalTransferedAmount"`
+			TotalServiceChargeAmount json.Number         `json:"totalServiceChargeAmount"`
+			Details                  []rawDribbletDetail `json:"userAssetDribbletDetails"`
+		} `json:"userAssetDribblets"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawLog); err != nil {
+		return nil, errors.Wrap(err, "rawLog unmarshal failed")
+	}
+
+	dl := &DustLog{
+		Total: rawLog.Total,
+	}
+	for _, rd := range rawLog.Dribblets {
+		t, err := timeFromUnixTimestampFloat(rd.OperateTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse Dribblet.OperateTime")
+		}
+		transfered, _ := rd.TotalTransferedAmount.Float64()
+		serviceCharge, _ := rd.TotalServiceChargeAmount.Float64()
+		d := &Dribblet{
+			TranID:                   rd.TransID,
+			OperateTime:              t,
+			TotalTransferedAmount:    transfered,
+			TotalServiceChargeAmount: serviceCharge,
+		}
+		for i := range rd.Details {
+			dd, err := rd.Details[i].dribbletDetail()
+			if err != nil {
+				return nil, err
+			}
+			d.Details = append(d.Details, dd)
+		}
+		dl.Dribblets = append(dl.Dribblets, d)
+	}
+	return dl, nil
+}
+
+func (as *apiService) DustAssets(ar AccountRequest) (*DustAssets, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(ar.Timestamp), 10)
+	if ar.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(ar.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/asset/dust-btc", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return dustAssetsFromRaw(textRes)
+}
+
+func dustAssetsFromRaw(textRes []byte) (*DustAssets, error) {
+	rawAssets := struct {
+		Details []struct {
+			Asset            string      `json:"asset"`
+			AssetFullName    string      `json:"assetFullName"`
+			AmountFree       json.Number `json:"amountFree"`
+			ToBTC            json.Number `json:"toBTC"`
+			ToBNB            json.Number `json:"toBNB"`
+			ToBNBOffExchange json.Number `json:"toBNBOffExchange"`
+			Exchange         json.Number `json:"exchange"`
+		} `json:"details"`
+		TotalTransferBtc   json.Number `json:"totalTransferBtc"`
+		TotalTransferBNB   json.Number `json:"totalTransferBNB"`
+		DribbletPercentage json.Number `json:"dribbletPercentage"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawAssets); err != nil {
+		return nil, errors.Wrap(err, "rawAssets unmarshal failed")
+	}
+
+	totalBTC, _ := rawAssets.TotalTransferBtc.Float64()
+	totalBNB, _ := rawAssets.TotalTransferBNB.Float64()
+	percentage, _ := rawAssets.DribbletPercentage.Float64()
+	da := &DustAssets{
+		TotalTransferBTC:   totalBTC,
+		TotalTransferBNB:   totalBNB,
+		DribbletPercentage: percentage,
+	}
+	for _, rd := range rawAssets.Details {
+		amountFree, _ := rd.AmountFree.Float64()
+		toBTC, _ := rd.ToBTC.Float64()
+		toBNB, _ := rd.ToBNB.Float64()
+		toBNBOffExchange, _ := rd.ToBNBOffExchange.Float64()
+		exchange, _ := rd.Exchange.Float64()
+		da.Details = append(da.Details, &DustAsset{
+			Asset:            rd.Asset,
+			AssetFullName:    rd.AssetFullName,
+			AmountFree:       amountFree,
+			ToBTC:            toBTC,
+			ToBNB:            toBNB,
+			ToBNBOffExchange: toBNBOffExchange,
+			Exchange:         exchange,
+		})
+	}
+	return da, nil
+}
+
+func (as *apiService) DustTransfer(dtr DustTransferRequest) (*DustTransferResult, error) {
+	if len(dtr.Assets) == 0 {
+		return nil, errors.New("no assets to transfer")
+	}
+	params := url.Values{}
+	for _, asset := range dtr.Assets {
+		params.Add("asset", asset)
+	}
+	params.Set("timestamp", strconv.FormatInt(unixMillis(dtr.Timestamp), 10))
+	if dtr.RecvWindow != 0 {
+		params.Set("recvWindow", strconv.FormatInt(recvWindow(dtr.RecvWindow), 10))
+	}
+
+	res, err := as.requestValues("POST", "sapi/v1/asset/dust", params, true, true)
+	if err != nil {
+		return nil, err
+	}
+	textRes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read response from dust.post")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, as.handleError(res.StatusCode, textRes)
+	}
+
+	return dustTransferResultFromRaw(textRes)
+}
+
+func dustTransferResultFromRaw(textRes []byte) (*DustTransferResult, error) {
+	rawResult := struct {
+		TotalServiceCharge json.Number         `json:"totalServiceCharge"`
+		TotalTransfered    json.Number         `json:"totalTransfered"`
+		TransferResult     []rawDribbletDetail `json:"transferResult"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawResult); err != nil {
+		return nil, errors.Wrap(err, "rawResult unmarshal failed")
+	}
+
+	serviceCharge, _ := rawResult.TotalServiceCharge.Float64()
+	transfered, _ := rawResult.TotalTransfered.Float64()
+	dtr := &DustTransferResult{
+		TotalServiceCharge: serviceCharge,
+		TotalTransfered:    transfered,
+	}
+	for i := range rawResult.TransferResult {
+		dd, err := rawResult.TransferResult[i].dribbletDetail()
+		if err != nil {
+			return nil, err
+		}
+		dtr.Results = append(dtr.Results, dd)
+	}
+	return dtr, nil
+}
+
+func (as *apiService) AssetDividendRecord(adr AssetDividendRequest) (*AssetDividendHistory, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(adr.Timestamp), 10)
+	if adr.Asset != "" {
+		params["asset"] = adr.Asset
+	}
+	if !adr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(adr.StartTime), 10)
+	}
+	if !adr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(adr.EndTime), 10)
+	}
+	if adr.Limit != 0 {
+		params["limit"] = strconv.Itoa(adr.Limit)
+	}
+	if adr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(adr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/asset/assetDividend", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return assetDividendHistoryFromRaw(textRes)
+}
+
+func assetDividendHistoryFromRaw(textRes []byte) (*AssetDividendHistory, error) {
+	rawHistory := struct {
+		Total int `json:"total"`
+		Rows  []struct {
+			ID      int64       `json:"id"`
+			TranID  int64       `json:"tranId"`
+			Asset   string      `json:"asset"`
+			Amount  json.Number `json:"amount"`
+			EnInfo  string      `json:"enInfo"`
+			DivTime float64     `json:"divTime"`
+		} `json:"rows"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawHistory); err != nil {
+		return nil, errors.Wrap(err, "rawHistory unmarshal failed")
+	}
+
+	adh := &AssetDividendHistory{
+		Total: rawHistory.Total,
+	}
+	for _, r := range rawHistory.Rows {
+		t, err := timeFromUnixTimestampFloat(r.DivTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse AssetDividend.DivTime")
+		}
+		amount, _ := r.Amount.Float64()
+		adh.Dividends = append(adh.Dividends, &AssetDividend{
+			ID:      r.ID,
+			TranID:  r.TranID,
+			Asset:   r.Asset,
+			Amount:  amount,
+			Info:    r.EnInfo,
+			DivTime: t,
+		})
+	}
+	return adh, nil
+}
+
+func (as *apiService) AssetDetail(adr AssetDetailRequest) (map[string]*AssetDetail, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(adr.Timestamp), 10)
+	if adr.Asset != "" {
+		params["asset"] = adr.Asset
+	}
+	if adr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(adr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/asset/assetDetail", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return assetDetailsFromRaw(textRes)
+}
+
+func assetDetailsFromRaw(textRes []byte) (map[string]*AssetDetail, error) {
+	rawDetails := map[string]struct {
+		MinWithdrawAmount json.Number `json:"minWithdrawAmount"`
+		DepositStatus     bool        `json:"depositStatus"`
+		WithdrawFee       json.Number `json:"withdrawFee"`
+		WithdrawStatus    bool        `json:"withdrawStatus"`
+		DepositTip        string      `json:"depositTip"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawDetails); err != nil {
+		return nil, errors.Wrap(err, "rawDetails unmarshal failed")
+	}
+
+	adm := make(map[string]*AssetDetail, len(rawDetails))
+	for asset, rd := range rawDetails {
+		minWithdraw, _ := rd.MinWithdrawAmount.Float64()
+		withdrawFee, _ := rd.WithdrawFee.Float64()
+		adm[asset] = &AssetDetail{
+			MinWithdrawAmount: minWithdraw,
+			DepositStatus:     rd.DepositStatus,
+			WithdrawFee:       withdrawFee,
+			WithdrawStatus:    rd.WithdrawStatus,
+			DepositTip:        rd.DepositTip,
+		}
+	}
+	return adm, nil
+}
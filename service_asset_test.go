@@ -0,0 +1,87 @@
+package binance
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDustLogFromRaw(t *testing.T) {
+	textRes := []byte(`{"total":8,"userAssetDribblets":[{"operateTime":1615985535000,
+		"totalTransferedAmount":"0.00132256","totalServiceChargeAmount":"0.00002699","transId":45178372831,
+		"userAssetDribbletDetails":[{"transId":4359321,"serviceChargeAmount":"0.000009","amount":"0.0009",
+		"operateTime":1615985535000,"transferedAmount":"0.000441","fromAsset":"USDT"}]}]}`)
+	dl, err := dustLogFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dl.Total != 8 || len(dl.Dribblets) != 1 {
+		t.Fatalf("unexpected dust log: %#v", dl)
+	}
+	d := dl.Dribblets[0]
+	if d.TranID != 45178372831 || d.TotalTransferedAmount != 0.00132256 || !d.OperateTime.Equal(time.Unix(1615985535, 0)) {
+		t.Errorf("unexpected dribblet: %#v", d)
+	}
+	if len(d.Details) != 1 || d.Details[0].TranID != 4359321 || d.Details[0].FromAsset != "USDT" || d.Details[0].TransferedAmount != 0.000441 {
+		t.Errorf("unexpected dribblet details: %#v", d.Details)
+	}
+}
+
+func TestDustAssetsFromRaw(t *testing.T) {
+	textRes := []byte(`{"details":[{"asset":"ADA","assetFullName":"ADA","amountFree":"6.21","toBTC":"0.00016848",
+		"toBNB":"0.01777302","toBNBOffExchange":"0.01741756","exchange":"0.00035546"}],
+		"totalTransferBtc":"0.00016848","totalTransferBNB":"0.01777302","dribbletPercentage":"0.02"}`)
+	da, err := dustAssetsFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if da.TotalTransferBNB != 0.01777302 || da.DribbletPercentage != 0.02 || len(da.Details) != 1 {
+		t.Fatalf("unexpected dust assets: %#v", da)
+	}
+	if d := da.Details[0]; d.Asset != "ADA" || d.AmountFree != 6.21 || d.ToBNBOffExchange != 0.01741756 || d.Exchange != 0.00035546 {
+		t.Errorf("unexpected dust asset: %#v", d)
+	}
+}
+
+func TestDustTransferResultFromRaw(t *testing.T) {
+	textRes := []byte(`{"totalServiceCharge":"0.02102542","totalTransfered":"1.05127099","transferResult":[
+		{"amount":"0.03000000","fromAsset":"ETH","operateTime":1563368549307,"serviceChargeAmount":"0.00500000",
+		"tranId":2970932918,"transferedAmount":"0.25000000"}]}`)
+	dtr, err := dustTransferResultFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dtr.TotalTransfered != 1.05127099 || len(dtr.Results) != 1 {
+		t.Fatalf("unexpected result: %#v", dtr)
+	}
+	if r := dtr.Results[0]; r.TranID != 2970932918 || r.Amount != 0.03 || r.ServiceChargeAmount != 0.005 {
+		t.Errorf("unexpected transfer: %#v", r)
+	}
+}
+
+func TestAssetDividendHistoryFromRaw(t *testing.T) {
+	textRes := []byte(`{"rows":[{"id":1637366104,"amount":"10.00000000","asset":"BHFT","divTime":1563189166000,
+		"enInfo":"BHFT distribution","tranId":2968885920}],"total":1}`)
+	adh, err := assetDividendHistoryFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adh.Total != 1 || len(adh.Dividends) != 1 {
+		t.Fatalf("unexpected history: %#v", adh)
+	}
+	if d := adh.Dividends[0]; d.ID != 1637366104 || d.TranID != 2968885920 || d.Amount != 10 || d.Info != "BHFT distribution" {
+		t.Errorf("unexpected dividend: %#v", d)
+	}
+}
+
+func TestAssetDetailsFromRaw(t *testing.T) {
+	textRes := []byte(`{"CTR":{"minWithdrawAmount":"70.00000000","depositStatus":false,"withdrawFee":35,
+		"withdrawStatus":true,"depositTip":"Delisted, Deposit Suspended"}}`)
+	adm, err := assetDetailsFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ad := adm["CTR"]
+	if ad == nil || ad.MinWithdrawAmount != 70 || ad.WithdrawFee != 35 || ad.DepositStatus || !ad.WithdrawStatus {
+		t.Errorf("unexpected asset detail: %#v", ad)
+	}
+}
@@ -0,0 +1,292 @@
+package binance
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func (as *apiService) ConvertExchangeInfo(cpr ConvertPairsRequest) ([]*ConvertPair, error) {
+	params := make(map[string]string)
+	if cpr.FromAsset != "" {
+		params["fromAsset"] = cpr.FromAsset
+	}
+	if cpr.ToAsset != "" {
+		params["toAsset"] = cpr.ToAsset
+	}
+
+	res, err := as.request("GET", "sapi/v1/convert/exchangeInfo", params, true, false)
+	if err != nil {
+		return nil, err
+	}
+	textRes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read response from convert/exchangeInfo.get")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, as.handleError(res.StatusCode, textRes)
+	}
+
+	return convertPairsFromRaw(textRes)
+}
+
+func convertPairsFromRaw(textRes []byte) ([]*ConvertPair, error) {
+	rawPairs := []struct {
+		FromAsset          string      `json:"fromAsset"`
+		ToAsset            string      `json:"toAsset"`
+		FromAssetMinAmount json.Number `json:"fromAssetMinAmount"`
+		FromAssetMaxAmount json.Number `json:"fromAssetMaxAmount"`
+		ToAssetMinAmount   json.Number `json:"toAssetMinAmount"`
+		ToAssetMaxAmount   json.Number `json:"toAssetMaxAmount"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawPairs); err != nil {
+		return nil, errors.Wrap(err, "rawPairs unmarshal failed")
+	}
+
+	var cpc []*ConvertPair
+	for _, rp := range rawPairs {
+		fromMin, _ := rp.FromAssetMinAmount.Float64()
+		fromMax, _ := rp.FromAssetMaxAmount.Float64()
+		toMin, _ := rp.ToAssetMinAmount.Float64()
+		toMax, _ := rp.ToAssetMaxAmount.Float64()
+		cpc = append(cpc, &ConvertPair{
+			FromAsset:          rp.FromAsset,
+			ToAsset:            rp.ToAsset,
+			FromAssetMinAmount: fromMin,
+			FromAssetMaxAmount: fromMax,
+			ToAssetMinAmount:   toMin,
+			ToAssetMaxAmount:   toMax,
+		})
+	}
+	return cpc, nil
+}
+
+func (as *apiService) ConvertGetQuote(cqr ConvertQuoteRequest) (*ConvertQuote, error) {
+	params := make(map[string]string)
+	params["fromAsset"] = cqr.FromAsset
+	params["toAsset"] = cqr.ToAsset
+	params["timestamp"] = strconv.FormatInt(unixMillis(cqr.Timestamp), 10)
+	if cqr.FromAmount != 0 {
+		params["fromAmount"] = strconv.FormatFloat(cqr.FromAmount, 'f', -1, 64)
+	}
+	if cqr.ToAmount != 0 {
+		params["toAmount"] = strconv.FormatFloat(cqr.ToAmount, 'f', -1, 64)
+	}
+	if cqr.WalletType != "" {
+		params["walletType"] = string(cqr.WalletType)
+	}
+	if cqr.ValidTime != 0 {
+		vt, err := validTimeParam(cqr.ValidTime)
+		if err != nil {
+			return nil, err
+		}
+		params["validTime"] = vt
+	}
+	if cqr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(cqr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/convert/getQuote", params)
+	if err != nil {
+		return nil, err
+	}
+	return convertQuoteFromRaw(textRes)
+}
+
+func convertQuoteFromRaw(textRes []byte) (*ConvertQuote, error) {
+	rawQuote := struct {
+		QuoteID        string      `json:"quoteId"`
+		Ratio          json.Number `json:"ratio"`
+		InverseRatio   json.Number `json:"inverseRatio"`
+		ValidTimestamp float64     `json:"validTimestamp"`
+		FromAmount     json.Number `json:"fromAmount"`
+		ToAmount       json.Number `json:"toAmount"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawQuote); err != nil {
+		return nil, errors.Wrap(err, "rawQuote unmarshal failed")
+	}
+
+	t, err := timeFromUnixTimestampFloat(rawQuote.ValidTimestamp)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse ConvertQuote.ValidTime")
+	}
+	ratio, _ := rawQuote.Ratio.Float64()
+	inverseRatio, _ := rawQuote.InverseRatio.Float64()
+	fromAmount, _ := rawQuote.FromAmount.Float64()
+	toAmount, _ := rawQuote.ToAmount.Float64()
+	return &ConvertQuote{
+		QuoteID:      rawQuote.QuoteID,
+		Ratio:        ratio,
+		InverseRatio: inverseRatio,
+		ValidTime:    t,
+		FromAmount:   fromAmount,
+		ToAmount:     toAmount,
+	}, nil
+}
+
+func (as *apiService) ConvertAcceptQuote(car ConvertAcceptRequest) (*ConvertOrder, error) {
+	params := make(map[string]string)
+	params["quoteId"] = car.QuoteID
+	params["timestamp"] = strconv.FormatInt(unixMillis(car.Timestamp), 10)
+	if car.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(car.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/convert/acceptQuote", params)
+	if err != nil {
+		return nil, err
+	}
+	rawOrder := rawConvertOrder{}
+	if err := json.Unmarshal(textRes, &rawOrder); err != nil {
+		return nil, errors.Wrap(err, "rawOrder unmarshal failed")
+	}
+	co, err := rawOrder.convertOrder()
+	if err != nil {
+		return nil, err
+	}
+	co.QuoteID = car.QuoteID
+	return co, nil
+}
+
+func (as *apiService) ConvertOrderStatus(cosr ConvertOrderStatusRequest) (*ConvertOrder, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(cosr.Timestamp), 10)
+	if cosr.OrderID != 0 {
+		params["orderId"] = strconv.FormatInt(cosr.OrderID, 10)
+	}
+	if cosr.QuoteID != "" {
+		params["quoteId"] = cosr.QuoteID
+	}
+	if cosr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(cosr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/convert/orderStatus", params)
+	if err != nil {
+		return nil, err
+	}
+	rawOrder := rawConvertOrder{}
+	if err := json.Unmarshal(textRes, &rawOrder); err != nil {
+		return nil, errors.Wrap(err, "rawOrder unmarshal failed")
+	}
+	return rawOrder.convertOrder()
+}
+
+func (as *apiService) ConvertTradeHistory(cthr ConvertTradeHistoryRequest) (*ConvertTradeHistory, error) {
+	params := make(map[string]string)
+	params["startTime"] = strconv.FormatInt(unixMillis(cthr.StartTime), 10)
+	params["endTime"] = strconv.FormatInt(unixMillis(cthr.EndTime), 10)
+	params["timestamp"] = strconv.FormatInt(unixMillis(cthr.Timestamp), 10)
+	if cthr.Limit != 0 {
+		params["limit"] = strconv.Itoa(cthr.Limit)
+	}
+	if cthr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(cthr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/convert/tradeFlow", params)
+	if err != nil {
+		return nil, err
+	}
+	return convertTradeHistoryFromRaw(textRes)
+}
+
+func convertTradeHistoryFromRaw(textRes []byte) (*ConvertTradeHistory, error) {
+	rawHistory := struct {
+		List      []rawConvertOrder `json:"list"`
+		StartTime float64           `json:"startTime"`
+		EndTime   float64           `json:"endTime"`
+		Limit     int               `json:"limit"`
+		MoreData  bool              `json:"moreData"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawHistory); err != nil {
+		return nil, errors.Wrap(err, "rawHistory unmarshal failed")
+	}
+
+	start, err := timeFromUnixTimestampFloat(rawHistory.StartTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse ConvertTradeHistory.StartTime")
+	}
+	end, err := timeFromUnixTimestampFloat(rawHistory.EndTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse ConvertTradeHistory.EndTime")
+	}
+	cth := &ConvertTradeHistory{
+		StartTime: start,
+		EndTime:   end,
+		Limit:     rawHistory.Limit,
+		MoreData:  rawHistory.MoreData,
+	}
+	for i := range rawHistory.List {
+		co, err := rawHistory.List[i].convertOrder()
+		if err != nil {
+			return nil, err
+		}
+		cth.Orders = append(cth.Orders, co)
+	}
+	return cth, nil
+}
+
+type rawConvertOrder struct {
+	OrderID      json.Number   `json:"orderId"`
+	QuoteID      string        `json:"quoteId"`
+	OrderStatus  ConvertStatus `json:"orderStatus"`
+	FromAsset    string        `json:"fromAsset"`
+	FromAmount   json.Number   `json:"fromAmount"`
+	ToAsset      string        `json:"toAsset"`
+	ToAmount     json.Number   `json:"toAmount"`
+	Ratio        json.Number   `json:"ratio"`
+	InverseRatio json.Number   `json:"inverseRatio"`
+	CreateTime   float64       `json:"createTime"`
+}
+
+func (rco *rawConvertOrder) convertOrder() (*ConvertOrder, error) {
+	// order ID is sent as string by acceptQuote and as number elsewhere
+	orderID, err := rco.OrderID.Int64()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse ConvertOrder.OrderID")
+	}
+	t, err := timeFromUnixTimestampFloat(rco.CreateTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse ConvertOrder.CreateTime")
+	}
+	fromAmount, _ := rco.FromAmount.Float64()
+	toAmount, _ := rco.ToAmount.Float64()
+	ratio, _ := rco.Ratio.Float64()
+	inverseRatio, _ := rco.InverseRatio.Float64()
+	return &ConvertOrder{
+		OrderID:      orderID,
+		QuoteID:      rco.QuoteID,
+		Status:       rco.OrderStatus,
+		FromAsset:    rco.FromAsset,
+		FromAmount:   fromAmount,
+		ToAsset:      rco.ToAsset,
+		ToAmount:     toAmount,
+		Ratio:        ratio,
+		InverseRatio: inverseRatio,
+		CreateTime:   t,
+	}, nil
+}
+
+// validTimes are quote valid times accepted by API.
+var validTimes = map[time.Duration]string{
+	10 * time.Second: "10s",
+	30 * time.Second: "30s",
+	time.Minute:      "1m",
+	2 * time.Minute:  "2m",
+}
+
+// validTimeParam formats d as quote valid time, only 10s, 30s, 1m and 2m are
+// accepted.
+func validTimeParam(d time.Duration) (string, error) {
+	vt, ok := validTimes[d]
+	if !ok {
+		return "", errors.Errorf("invalid quote valid time %s, 10s, 30s, 1m or 2m expected", d)
+	}
+	return vt, nil
+}
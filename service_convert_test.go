@@ -0,0 +1,73 @@
+package binance
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestConvertQuoteFromRaw(t *testing.T) {
+	textRes := []byte(`{"quoteId":"12415572564","ratio":"38163.7","inverseRatio":"0.0000262",
+		"validTimestamp":1623319461670,"toAmount":"3816.37","fromAmount":"0.1"}`)
+	cq, err := convertQuoteFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cq.QuoteID != "12415572564" || cq.Ratio != 38163.7 || cq.ToAmount != 3816.37 || cq.FromAmount != 0.1 ||
+		!cq.ValidTime.Equal(time.Unix(0, 1623319461670*int64(time.Millisecond))) {
+		t.Errorf("unexpected quote: %#v", cq)
+	}
+}
+
+func TestRawConvertOrder(t *testing.T) {
+	// acceptQuote returns order ID as string
+	rco := rawConvertOrder{}
+	if err := json.Unmarshal([]byte(`{"orderId":"933256278426274426","createTime":1623381330472,
+		"orderStatus":"PROCESS"}`), &rco); err != nil {
+		t.Fatal(err)
+	}
+	co, err := rco.convertOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if co.OrderID != 933256278426274426 || co.Status != ConvertProcessing {
+		t.Errorf("unexpected order: %#v", co)
+	}
+}
+
+func TestConvertTradeHistoryFromRaw(t *testing.T) {
+	textRes := []byte(`{"list":[{"quoteId":"f3b91c525b2644c7bc1e1cd31b6e1aa6","orderId":940708407462087195,
+		"orderStatus":"SUCCESS","fromAsset":"USDT","fromAmount":"20","toAsset":"BNB","toAmount":"0.06154036",
+		"ratio":"0.00307702","inverseRatio":"324.99","createTime":1624248872184}],
+		"startTime":1623824139000,"endTime":1626416139000,"limit":100,"moreData":false}`)
+	cth, err := convertTradeHistoryFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cth.Limit != 100 || cth.MoreData || !cth.StartTime.Equal(time.Unix(1623824139, 0)) || len(cth.Orders) != 1 {
+		t.Fatalf("unexpected history: %#v", cth)
+	}
+	o := cth.Orders[0]
+	if o.OrderID != 940708407462087195 || o.QuoteID != "f3b91c525b2644c7bc1e1cd31b6e1aa6" || o.Status != ConvertSuccess ||
+		o.ToAmount != 0.06154036 || o.InverseRatio != 324.99 {
+		t.Errorf("unexpected order: %#v", o)
+	}
+}
+
+func TestValidTimeParam(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		10 * time.Second: "10s",
+		30 * time.Second: "30s",
+		time.Minute:      "1m",
+		2 * time.Minute:  "2m",
+	} {
+		if got, err := validTimeParam(d); err != nil || got != want {
+			t.Errorf("validTimeParam(%s) = %s, %v, want %s", d, got, err, want)
+		}
+	}
+	for _, d := range []time.Duration{time.Second, 15 * time.Second, 90 * time.Second, 5 * time.Minute} {
+		if got, err := validTimeParam(d); err == nil {
+			t.Errorf("invalid valid time %s accepted: %s", d, got)
+		}
+	}
+}
@@ -3,7 +3,9 @@ package binance
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/go-kit/kit/log"
@@ -20,10 +22,19 @@ type Service interface {
 	Time() (time.Time, error)
 	OrderBook(obr OrderBookRequest) (*OrderBook, error)
 	AggTrades(atr AggTradesRequest) ([]*AggTrade, error)
+	RecentTrades(rtr RecentTradesRequest) ([]*MarketTrade, error)
+	HistoricalTrades(htr HistoricalTradesRequest) ([]*MarketTrade, error)
 	Klines(kr KlinesRequest) ([]*Kline, error)
+	UIKlines(kr KlinesRequest) ([]*Kline, error)
 	Ticker24(tr TickerRequest) (*Ticker24, error)
 	TickerAllPrices() ([]*PriceTicker, error)
 	TickerAllBooks() ([]*BookTicker, error)
+	AvgPrice(apr AvgPriceRequest) (*AvgPrice, error)
+	TickerPrice(tr TickersRequest) ([]*PriceTicker, error)
+	BookTicker(tr TickersRequest) ([]*BookTicker, error)
+	Tickers24(tr TickersRequest) ([]*Ticker24, error)
+	RollingTickers(rtr RollingTickersRequest) ([]*Ticker24, error)
+	TradingDayTickers(tr TradingDayTickersRequest) ([]*Ticker24, error)
 
 	NewOrder(or NewOrderRequest) (*ProcessedOrder, error)
 	NewOrderTest(or NewOrderRequest) error
@@ -34,9 +45,43 @@ type Service interface {
 
 	Account(ar AccountRequest) (*Account, error)
 	MyTrades(mtr MyTradesRequest) ([]*Trade, error)
+	AccountSnapshot(asr AccountSnapshotRequest) ([]*AccountSnapshot, error)
+	APIKeyPermission(ar AccountRequest) (*APIKeyPermission, error)
+	AccountStatus(ar AccountRequest) (*AccountStatus, error)
+	APITradingStatus(ar AccountRequest) (*APITradingStatus, error)
+	TradeFee(tfr TradeFeeRequest) ([]*TradeFee, error)
+	Commission(cr CommissionRequest) (*AccountCommission, error)
 	Withdraw(wr WithdrawRequest) (*WithdrawResult, error)
-	DepositHistory(hr HistoryRequest) ([]*Deposit, error)
-	WithdrawHistory(hr HistoryRequest) ([]*Withdrawal, error)
+	DepositHistory(hr DepositHistoryRequest) ([]*Deposit, error)
+	WithdrawHistory(hr WithdrawHistoryRequest) ([]*Withdrawal, error)
+	CoinConfig(ccr CoinConfigRequest) ([]*CoinConfig, error)
+	DepositAddress(dar DepositAddressRequest) (*DepositAddress, error)
+	SubAccounts(sar SubAccountsRequest) ([]*SubAccount, error)
+	CreateVirtualSubAccount(cvr CreateVirtualSubAccountRequest) (string, error)
+	SubAccountAssets(sar SubAccountRequest) ([]*Balance, error)
+	SubAccountMarginAccount(sar SubAccountRequest) (*SubAccountMarginAccount, error)
+	SubAccountTransfer(str SubAccountTransferRequest) (*SubAccountTransferResult, error)
+	SubAccountTransferHistory(sthr SubAccountTransferHistoryRequest) (*SubAccountTransferHistory, error)
+	DustLog(dlr DustLogRequest) (*DustLog, error)
+	DustAssets(ar AccountRequest) (*DustAssets, error)
+	DustTransfer(dtr DustTransferRequest) (*DustTransferResult, error)
+	AssetDividendRecord(adr AssetDividendRequest) (*AssetDividendHistory, error)
+	AssetDetail(adr AssetDetailRequest) (map[string]*AssetDetail, error)
+	FlexibleEarnProducts(epr EarnProductsRequest) (*FlexibleEarnProductList, error)
+	LockedEarnProducts(epr EarnProductsRequest) (*LockedEarnProductList, error)
+	SubscribeFlexibleEarn(sfr SubscribeFlexibleEarnRequest) (*EarnSubscription, error)
+	SubscribeLockedEarn(slr SubscribeLockedEarnRequest) (*EarnSubscription, error)
+	RedeemFlexibleEarn(rfr RedeemFlexibleEarnRequest) (*EarnRedemption, error)
+	RedeemLockedEarn(rlr RedeemLockedEarnRequest) (*EarnRedemption, error)
+	FlexibleEarnPositions(fpr FlexibleEarnPositionsRequest) (*FlexibleEarnPositionList, error)
+	LockedEarnPositions(lpr LockedEarnPositionsRequest) (*LockedEarnPositionList, error)
+	FlexibleEarnRewards(frr FlexibleEarnRewardsRequest) (*FlexibleEarnRewardHistory, error)
+	LockedEarnRewards(lrr LockedEarnRewardsRequest) (*LockedEarnRewardHistory, error)
+	ConvertExchangeInfo(cpr ConvertPairsRequest) ([]*ConvertPair, error)
+	ConvertGetQuote(cqr ConvertQuoteRequest) (*ConvertQuote, error)
+	ConvertAcceptQuote(car ConvertAcceptRequest) (*ConvertOrder, error)
+	ConvertOrderStatus(cosr ConvertOrderStatusRequest) (*ConvertOrder, error)
+	ConvertTradeHistory(cthr ConvertTradeHistoryRequest) (*ConvertTradeHistory, error)
 
 	StartUserDataStream() (*Stream, error)
 	KeepAliveUserDataStream(s *Stream) error
@@ -52,74 +97,153 @@ type Service interface {
 	QueryMarginOrder(qor QueryOrderRequest) (*ExecutedOrder, error)
 	CancelMarginOrder(cor CancelOrderRequest) (*CanceledOrder, error)
 	OpenMarginOrders(oor OpenOrdersRequest) ([]*ExecutedOrder, error)
+	CancelOpenMarginOrders(oor OpenOrdersRequest) ([]*CanceledOrder, error)
+	NewMarginOCO(nor NewMarginOCORequest) (*OrderList, error)
+	QueryMarginOCO(olr OrderListRequest) (*OrderList, error)
+	CancelMarginOCO(olr OrderListRequest) (*OrderList, error)
+	MarginOrderCount(mocr MarginOrderCountRequest) ([]*OrderCount, error)
 	AllMarginOrders(aor AllOrdersRequest) ([]*ExecutedOrder, error)
 	MarginAccount(ar AccountRequest) (*MarginAccount, error)
+	IsolatedMarginAccount(imar IsolatedMarginAccountRequest) (*IsolatedMarginAccount, error)
+	EnableIsolatedMarginAccount(isr IsolatedSymbolRequest) error
+	DisableIsolatedMarginAccount(isr IsolatedSymbolRequest) error
+	AllIsolatedMarginSymbols(isr IsolatedSymbolRequest) ([]*IsolatedMarginSymbol, error)
 	MyMarginTrades(mtr MyTradesRequest) ([]*Trade, error)
+	MarginInterestHistory(ihr InterestHistoryRequest) (*InterestHistory, error)
+	ForceLiquidations(flr ForceLiquidationRequest) (*ForceLiquidationHistory, error)
+	MarginInterestRateHistory(irhr InterestRateHistoryRequest) ([]*InterestRate, error)
+	CrossMarginCollateralRatio() ([]*CollateralRatio, error)
+	MarginPriceIndex(mpir MarginPriceIndexRequest) (*MarginPriceIndex, error)
 	AllMarginAssets(ar AccountRequest) ([]*MarginAsset, error)
-	MaxBorrow(mbr MaxMarginRequest) (float64, error)
+	MaxBorrow(mbr MaxMarginRequest) (*MaxBorrowable, error)
 	MaxTransfer(mbr MaxMarginRequest) (float64, error)
+	MarginBorrow(mlr MarginLoanRequest) (*MarginLoanResult, error)
+	MarginRepay(mlr MarginLoanRequest) (*MarginLoanResult, error)
+	MarginLoanRecords(mlrr MarginLoanRecordsRequest) (*MarginLoanHistory, error)
+	MarginRepayRecords(mlrr MarginLoanRecordsRequest) (*MarginLoanHistory, error)
+
+	UniversalTransfer(utr UniversalTransferRequest) (*TransferResult, error)
+	UniversalTransferHistory(uthr UniversalTransferHistoryRequest) (*TransferHistory, error)
+	MarginTransfer(mtr MarginTransferRequest) (*TransferResult, error)
+	IsolatedMarginTransfer(imtr IsolatedMarginTransferRequest) (*TransferResult, error)
+	MarginTransferHistory(mthr MarginTransferHistoryRequest) (*MarginTransferHistory, error)
 }
 
 type apiService struct {
-	URL    string
-	APIKey string
-	Signer Signer
-	Logger log.Logger
-	Ctx    context.Context
+	URL        string
+	APIKey     string
+	Signer     Signer
+	Logger     log.Logger
+	Ctx        context.Context
+	Middleware []Middleware
+
+	handler CallHandler
 }
 
 // NewAPIService creates instance of Service.
 //
 // If logger or ctx are not provided, NopLogger and Background context are used as default.
 // You can use context for one-time request cancel (e.g. when shutting down the app).
-func NewAPIService(url, apiKey string, signer Signer, logger log.Logger, ctx context.Context) Service {
+// Middleware wraps every REST call and websocket message, see Middleware.
+func NewAPIService(url, apiKey string, signer Signer, logger log.Logger, ctx context.Context,
+	middleware ...Middleware) Service {
 	if logger == nil {
 		logger = log.NewNopLogger()
 	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
-	return &apiService{
-		URL:    url,
-		APIKey: apiKey,
-		Signer: signer,
-		Logger: logger,
-		Ctx:    ctx,
+	as := &apiService{
+		URL:        url,
+		APIKey:     apiKey,
+		Signer:     signer,
+		Logger:     logger,
+		Ctx:        ctx,
+		Middleware: middleware,
 	}
+	as.handler = chainCall(as.send, middleware)
+	return as
 }
 
 func (as *apiService) request(method string, endpoint string, params map[string]string,
 	apiKey bool, sign bool) (*http.Response, error) {
+	q := url.Values{}
+	for key, val := range params {
+		q.Add(key, val)
+	}
+	return as.requestValues(method, endpoint, q, apiKey, sign)
+}
+
+// requestValues is request for parameters that repeat, such as asset list of
+// DustTransfer.
+func (as *apiService) requestValues(method string, endpoint string, q url.Values,
+	apiKey bool, sign bool) (*http.Response, error) {
+	return as.handler(&Call{
+		Ctx:      as.Ctx,
+		Method:   method,
+		Endpoint: endpoint,
+		Params:   q,
+		Header:   http.Header{},
+		APIKey:   apiKey,
+		Sign:     sign,
+	})
+}
+
+// send is the innermost CallHandler, it signs call and sends it.
+func (as *apiService) send(call *Call) (*http.Response, error) {
 	transport := &http.Transport{}
 	client := &http.Client{
 		Transport: transport,
 	}
 
-	url := fmt.Sprintf("%s/%s", as.URL, endpoint)
-	req, err := http.NewRequest(method, url, nil)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to create request")
+	cr := newCanonicalRequest(call.Method, fmt.Sprintf("%s/%s", as.URL, call.Endpoint), call.Params)
+	if call.APIKey {
+		cr.APIKey = as.APIKey
 	}
-	req.WithContext(as.Ctx)
-
-	q := req.URL.Query()
-	for key, val := range params {
-		q.Add(key, val)
+	if call.Sign {
+		if err := cr.sign(as.Signer); err != nil {
+			level.Error(as.Logger).Log("msg", "unable to sign request", "endpoint", call.Endpoint, "err", err)
+			return nil, err
+		}
 	}
-	if apiKey {
-		req.Header.Add("X-MBX-APIKEY", as.APIKey)
+	level.Debug(as.Logger).Log("canonicalRequest", cr.dump())
+	req, err := cr.httpRequest()
+	if err != nil {
+		return nil, err
 	}
-	req.URL.RawQuery = q.Encode()
-	if sign {
-		level.Debug(as.Logger).Log("queryString", q.Encode())
-		signature := as.Signer.Sign([]byte(q.Encode()))
-		level.Debug(as.Logger).Log("signature", signature)
-		req.URL.RawQuery += "&signature=" + signature
+	for key, vals := range call.Header {
+		for _, val := range vals {
+			req.Header.Add(key, val)
+		}
 	}
+	req = req.WithContext(call.Ctx)
 
+	start := time.Now()
 	resp, err := client.Do(req)
+	if err != nil {
+		err = redactError(err)
+	}
+	level.Debug(as.Logger).Log(requestLog(call.Method, call.Endpoint, resp, time.Since(start), err)...)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
+
+// signedRequest sends signed request and returns body of successful response.
+func (as *apiService) signedRequest(method string, endpoint string, params map[string]string) ([]byte, error) {
+	res, err := as.request(method, endpoint, params, true, true)
+	if err != nil {
+		return nil, err
+	}
+	textRes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read response from "+endpoint)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, as.handleError(res.StatusCode, textRes)
+	}
+	return textRes, nil
+}
@@ -7,7 +7,7 @@ import (
 
 func TestErrorHandler(t *testing.T) {
 	as := NewAPIService("", "", nil, nil, context.Background()).(*apiService)
-	err := as.handleError([]byte(`{"code":-1105,"msg":"Parameter 'side' was was empty."}`))
+	err := as.handleError(400, []byte(`{"code":-1105,"msg":"Parameter 'side' was was empty."}`))
 	tErr, ok := err.(*Error)
 	if !ok {
 		t.Errorf("invalid type of error returned: %T", tErr)
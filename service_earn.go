@@ -0,0 +1,626 @@
+package binance
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+func (as *apiService) FlexibleEarnProducts(epr EarnProductsRequest) (*FlexibleEarnProductList, error) {
+	textRes, err := as.signedRequest("GET", "sapi/v1/simple-earn/flexible/list", earnProductsParams(epr))
+	if err != nil {
+		return nil, err
+	}
+	return flexibleEarnProductsFromRaw(textRes)
+}
+
+func flexibleEarnProductsFromRaw(textRes []byte) (*FlexibleEarnProductList, error) {
+	rawList := struct {
+		Total int `json:"total"`
+		Rows  []struct {
+			ProductID                  string                 `json:"productId"`
+			Asset                      string                 `json:"asset"`
+			LatestAnnualPercentageRate json.Number            `json:"latestAnnualPercentageRate"`
+			TierAnnualPercentageRate   map[string]json.Number `json:"tierAnnualPercentageRate"`
+			AirDropPercentageRate      json.Number            `json:"airDropPercentageRate"`
+			CanPurchase                bool                   `json:"canPurchase"`
+			CanRedeem                  bool                   `json:"canRedeem"`
+			IsSoldOut                  bool                   `json:"isSoldOut"`
+			Hot                        bool                   `json:"hot"`
+			MinPurchaseAmount          json.Number            `json:"minPurchaseAmount"`
+			SubscriptionStartTime      json.Number            `json:"subscriptionStartTime"`
+			Status                     string                 `json:"status"`
+		} `json:"rows"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawList); err != nil {
+		return nil, errors.Wrap(err, "rawList unmarshal failed")
+	}
+
+	fpl := &FlexibleEarnProductList{
+		Total: rawList.Total,
+	}
+	for _, r := range rawList.Rows {
+		t, err := timeFromUnixTimestampNumber(r.SubscriptionStartTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse FlexibleEarnProduct.SubscriptionStartTime")
+		}
+		latestRate, _ := r.LatestAnnualPercentageRate.Float64()
+		airDropRate, _ := r.AirDropPercentageRate.Float64()
+		minPurchase, _ := r.MinPurchaseAmount.Float64()
+		fpl.Products = append(fpl.Products, &FlexibleEarnProduct{
+			ProductID:                  r.ProductID,
+			Asset:                      r.Asset,
+			LatestAnnualPercentageRate: latestRate,
+			TierAnnualPercentageRate:   tierRates(r.TierAnnualPercentageRate),
+			AirDropPercentageRate:      airDropRate,
+			CanPurchase:                r.CanPurchase,
+			CanRedeem:                  r.CanRedeem,
+			IsSoldOut:                  r.IsSoldOut,
+			Hot:                        r.Hot,
+			MinPurchaseAmount:          minPurchase,
+			SubscriptionStartTime:      t,
+			Status:                     r.Status,
+		})
+	}
+	return fpl, nil
+}
+
+func (as *apiService) LockedEarnProducts(epr EarnProductsRequest) (*LockedEarnProductList, error) {
+	textRes, err := as.signedRequest("GET", "sapi/v1/simple-earn/locked/list", earnProductsParams(epr))
+	if err != nil {
+		return nil, err
+	}
+	return lockedEarnProductsFromRaw(textRes)
+}
+
+func lockedEarnProductsFromRaw(textRes []byte) (*LockedEarnProductList, error) {
+	rawList := struct {
+		Total int `json:"total"`
+		Rows  []struct {
+			ProjectID string `json:"projectId"`
+			Detail    struct {
+				Asset                 string      `json:"asset"`
+				RewardAsset           string      `json:"rewardAsset"`
+				Duration              int         `json:"duration"`
+				Renewable             bool        `json:"renewable"`
+				IsSoldOut             bool        `json:"isSoldOut"`
+				APR                   json.Number `json:"apr"`
+				Status                string      `json:"status"`
+				SubscriptionStartTime json.Number `json:"subscriptionStartTime"`
+				ExtraRewardAsset      string      `json:"extraRewardAsset"`
+				ExtraRewardAPR        json.Number `json:"extraRewardAPR"`
+			} `json:"detail"`
+			Quota struct {
+				TotalPersonalQuota json.Number `json:"totalPersonalQuota"`
+				Minimum            json.Number `json:"minimum"`
+			} `json:"quota"`
+		} `json:"rows"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawList); err != nil {
+		return nil, errors.Wrap(err, "rawList unmarshal failed")
+	}
+
+	lpl := &LockedEarnProductList{
+		Total: rawList.Total,
+	}
+	for _, r := range rawList.Rows {
+		t, err := timeFromUnixTimestampNumber(r.Detail.SubscriptionStartTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse LockedEarnProduct.SubscriptionStartTime")
+		}
+		apr, _ := r.Detail.APR.Float64()
+		extraAPR, _ := r.Detail.ExtraRewardAPR.Float64()
+		quota, _ := r.Quota.TotalPersonalQuota.Float64()
+		minimum, _ := r.Quota.Minimum.Float64()
+		lpl.Products = append(lpl.Products, &LockedEarnProduct{
+			ProjectID:             r.ProjectID,
+			Asset:                 r.Detail.Asset,
+			RewardAsset:           r.Detail.RewardAsset,
+			Duration:              r.Detail.Duration,
+			Renewable:             r.Detail.Renewable,
+			IsSoldOut:             r.Detail.IsSoldOut,
+			APR:                   apr,
+			Status:                r.Detail.Status,
+			SubscriptionStartTime: t,
+			ExtraRewardAsset:      r.Detail.ExtraRewardAsset,
+			ExtraRewardAPR:        extraAPR,
+			TotalPersonalQuota:    quota,
+			Minimum:               minimum,
+		})
+	}
+	return lpl, nil
+}
+
+func (as *apiService) SubscribeFlexibleEarn(sfr SubscribeFlexibleEarnRequest) (*EarnSubscription, error) {
+	params := make(map[string]string)
+	params["productId"] = sfr.ProductID
+	params["amount"] = strconv.FormatFloat(sfr.Amount, 'f', -1, 64)
+	params["timestamp"] = strconv.FormatInt(unixMillis(sfr.Timestamp), 10)
+	if sfr.AutoSubscribe != nil {
+		params["autoSubscribe"] = strconv.FormatBool(*sfr.AutoSubscribe)
+	}
+	if sfr.SourceAccount != "" {
+		params["sourceAccount"] = string(sfr.SourceAccount)
+	}
+	if sfr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(sfr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/simple-earn/flexible/subscribe", params)
+	if err != nil {
+		return nil, err
+	}
+	return earnSubscriptionFromRaw(textRes)
+}
+
+func (as *apiService) SubscribeLockedEarn(slr SubscribeLockedEarnRequest) (*EarnSubscription, error) {
+	params := make(map[string]string)
+	params["projectId"] = slr.ProjectID
+	params["amount"] = strconv.FormatFloat(slr.Amount, 'f', -1, 64)
+	params["timestamp"] = strconv.FormatInt(unixMillis(slr.Timestamp), 10)
+	if slr.AutoSubscribe != nil {
+		params["autoSubscribe"] = strconv.FormatBool(*slr.AutoSubscribe)
+	}
+	if slr.SourceAccount != "" {
+		params["sourceAccount"] = string(slr.SourceAccount)
+	}
+	if slr.RedeemTo != "" {
+		params["redeemTo"] = string(slr.RedeemTo)
+	}
+	if slr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(slr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/simple-earn/locked/subscribe", params)
+	if err != nil {
+		return nil, err
+	}
+	return earnSubscriptionFromRaw(textRes)
+}
+
+func earnSubscriptionFromRaw(textRes []byte) (*EarnSubscription, error) {
+	rawSubscription := struct {
+		PurchaseID int64       `json:"purchaseId"`
+		PositionID json.Number `json:"positionId"`
+		Success    bool        `json:"success"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawSubscription); err != nil {
+		return nil, errors.Wrap(err, "rawSubscription unmarshal failed")
+	}
+	positionID, _ := rawSubscription.PositionID.Int64()
+	return &EarnSubscription{
+		PurchaseID: rawSubscription.PurchaseID,
+		PositionID: positionID,
+		Success:    rawSubscription.Success,
+	}, nil
+}
+
+func (as *apiService) RedeemFlexibleEarn(rfr RedeemFlexibleEarnRequest) (*EarnRedemption, error) {
+	params := make(map[string]string)
+	params["productId"] = rfr.ProductID
+	params["timestamp"] = strconv.FormatInt(unixMillis(rfr.Timestamp), 10)
+	if rfr.RedeemAll {
+		params["redeemAll"] = "true"
+	} else {
+		params["amount"] = strconv.FormatFloat(rfr.Amount, 'f', -1, 64)
+	}
+	if rfr.DestAccount != "" {
+		params["destAccount"] = string(rfr.DestAccount)
+	}
+	if rfr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(rfr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/simple-earn/flexible/redeem", params)
+	if err != nil {
+		return nil, err
+	}
+	return earnRedemptionFromRaw(textRes)
+}
+
+func (as *apiService) RedeemLockedEarn(rlr RedeemLockedEarnRequest) (*EarnRedemption, error) {
+	params := make(map[string]string)
+	params["positionId"] = strconv.FormatInt(rlr.PositionID, 10)
+	params["timestamp"] = strconv.FormatInt(unixMillis(rlr.Timestamp), 10)
+	if rlr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(rlr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/simple-earn/locked/redeem", params)
+	if err != nil {
+		return nil, err
+	}
+	return earnRedemptionFromRaw(textRes)
+}
+
+func earnRedemptionFromRaw(textRes []byte) (*EarnRedemption, error) {
+	rawRedemption := struct {
+		RedeemID int64 `json:"redeemId"`
+		Success  bool  `json:"success"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawRedemption); err != nil {
+		return nil, errors.Wrap(err, "rawRedemption unmarshal failed")
+	}
+	return &EarnRedemption{
+		RedeemID: rawRedemption.RedeemID,
+		Success:  rawRedemption.Success,
+	}, nil
+}
+
+func (as *apiService) FlexibleEarnPositions(fpr FlexibleEarnPositionsRequest) (*FlexibleEarnPositionList, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(fpr.Timestamp), 10)
+	if fpr.Asset != "" {
+		params["asset"] = fpr.Asset
+	}
+	if fpr.ProductID != "" {
+		params["productId"] = fpr.ProductID
+	}
+	if fpr.Current != 0 {
+		params["current"] = strconv.Itoa(fpr.Current)
+	}
+	if fpr.Size != 0 {
+		params["size"] = strconv.Itoa(fpr.Size)
+	}
+	if fpr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(fpr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/simple-earn/flexible/position", params)
+	if err != nil {
+		return nil, err
+	}
+	return flexibleEarnPositionsFromRaw(textRes)
+}
+
+func flexibleEarnPositionsFromRaw(textRes []byte) (*FlexibleEarnPositionList, error) {
+	rawList := struct {
+		Total int `json:"total"`
+		Rows  []struct {
+			ProductID                      string                 `json:"productId"`
+			Asset                          string                 `json:"asset"`
+			TotalAmount                    json.Number            `json:"totalAmount"`
+			LatestAnnualPercentageRate     json.Number            `json:"latestAnnualPercentageRate"`
+			TierAnnualPercentageRate       map[string]json.Number `json:"tierAnnualPercentageRate"`
+			YesterdayAirdropPercentageRate json.Number            `json:"yesterdayAirdropPercentageRate"`
+			AirDropAsset                   string                 `json:"airDropAsset"`
+			CanRedeem                      bool                   `json:"canRedeem"`
+			CollateralAmount               json.Number            `json:"collateralAmount"`
+			YesterdayRealTimeRewards       json.Number            `json:"yesterdayRealTimeRewards"`
+			CumulativeBonusRewards         json.Number            `json:"cumulativeBonusRewards"`
+			CumulativeRealTimeRewards      json.Number            `json:"cumulativeRealTimeRewards"`
+			CumulativeTotalRewards         json.Number            `json:"cumulativeTotalRewards"`
+			AutoSubscribe                  bool                   `json:"autoSubscribe"`
+		} `json:"rows"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawList); err != nil {
+		return nil, errors.Wrap(err, "rawList unmarshal failed")
+	}
+
+	fpl := &FlexibleEarnPositionList{
+		Total: rawList.Total,
+	}
+	for _, r := range rawList.Rows {
+		totalAmount, _ := r.TotalAmount.Float64()
+		latestRate, _ := r.LatestAnnualPercentageRate.Float64()
+		airdropRate, _ := r.YesterdayAirdropPercentageRate.Float64()
+		collateral, _ := r.CollateralAmount.Float64()
+		yesterdayRewards, _ := r.YesterdayRealTimeRewards.Float64()
+		bonusRewards, _ := r.CumulativeBonusRewards.Float64()
+		realTimeRewards, _ := r.CumulativeRealTimeRewards.Float64()
+		totalRewards, _ := r.CumulativeTotalRewards.Float64()
+		fpl.Positions = append(fpl.Positions, &FlexibleEarnPosition{
+			ProductID:                      r.ProductID,
+			Asset:                          r.Asset,
+			TotalAmount:                    totalAmount,
+			LatestAnnualPercentageRate:     latestRate,
+			TierAnnualPercentageRate:       tierRates(r.TierAnnualPercentageRate),
+			YesterdayAirdropPercentageRate: airdropRate,
+			AirDropAsset:                   r.AirDropAsset,
+			CanRedeem:                      r.CanRedeem,
+			CollateralAmount:               collateral,
+			YesterdayRealTimeRewards:       yesterdayRewards,
+			CumulativeBonusRewards:         bonusRewards,
+			CumulativeRealTimeRewards:      realTimeRewards,
+			CumulativeTotalRewards:         totalRewards,
+			AutoSubscribe:                  r.AutoSubscribe,
+		})
+	}
+	return fpl, nil
+}
+
+func (as *apiService) LockedEarnPositions(lpr LockedEarnPositionsRequest) (*LockedEarnPositionList, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(lpr.Timestamp), 10)
+	if lpr.Asset != "" {
+		params["asset"] = lpr.Asset
+	}
+	if lpr.PositionID != 0 {
+		params["positionId"] = strconv.FormatInt(lpr.PositionID, 10)
+	}
+	if lpr.ProjectID != "" {
+		params["projectId"] = lpr.ProjectID
+	}
+	if lpr.Current != 0 {
+		params["current"] = strconv.Itoa(lpr.Current)
+	}
+	if lpr.Size != 0 {
+		params["size"] = strconv.Itoa(lpr.Size)
+	}
+	if lpr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(lpr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/simple-earn/locked/position", params)
+	if err != nil {
+		return nil, err
+	}
+	return lockedEarnPositionsFromRaw(textRes)
+}
+
+func lockedEarnPositionsFromRaw(textRes []byte) (*LockedEarnPositionList, error) {
+	rawList := struct {
+		Total int `json:"total"`
+		Rows  []struct {
+			PositionID        json.Number  `json:"positionId"`
+			ParentPositionID  json.Number  `json:"parentPositionId"`
+			ProjectID         string       `json:"projectId"`
+			Asset             string       `json:"asset"`
+			Amount            json.Number  `json:"amount"`
+			PurchaseTime      json.Number  `json:"purchaseTime"`
+			Duration          json.Number  `json:"duration"`
+			AccrualDays       json.Number  `json:"accrualDays"`
+			RewardAsset       string       `json:"rewardAsset"`
+			APY               json.Number  `json:"APY"`
+			RewardAmt         json.Number  `json:"rewardAmt"`
+			ExtraRewardAsset  string       `json:"extraRewardAsset"`
+			ExtraRewardAPR    json.Number  `json:"extraRewardAPR"`
+			EstExtraRewardAmt json.Number  `json:"estExtraRewardAmt"`
+			NextPay           json.Number  `json:"nextPay"`
+			NextPayDate       json.Number  `json:"nextPayDate"`
+			RedeemAmountEarly json.Number  `json:"redeemAmountEarly"`
+			RewardsEndDate    json.Number  `json:"rewardsEndDate"`
+			DeliverDate       json.Number  `json:"deliverDate"`
+			RedeemingAmt      json.Number  `json:"redeemingAmt"`
+			RedeemTo          EarnRedeemTo `json:"redeemTo"`
+			CanRedeemEarly    bool         `json:"canRedeemEarly"`
+			CanFastRedemption bool         `json:"canFastRedemption"`
+			AutoSubscribe     bool         `json:"autoSubscribe"`
+			Type              string       `json:"type"`
+			Status            string       `json:"status"`
+		} `json:"rows"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawList); err != nil {
+		return nil, errors.Wrap(err, "rawList unmarshal failed")
+	}
+
+	lpl := &LockedEarnPositionList{
+		Total: rawList.Total,
+	}
+	for _, r := range rawList.Rows {
+		purchaseTime, err := timeFromUnixTimestampNumber(r.PurchaseTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse LockedEarnPosition.PurchaseTime")
+		}
+		nextPayDate, err := timeFromUnixTimestampNumber(r.NextPayDate)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse LockedEarnPosition.NextPayDate")
+		}
+		rewardsEndDate, err := timeFromUnixTimestampNumber(r.RewardsEndDate)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse LockedEarnPosition.RewardsEndDate")
+		}
+		deliverDate, err := timeFromUnixTimestampNumber(r.DeliverDate)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse LockedEarnPosition.DeliverDate")
+		}
+		positionID, _ := r.PositionID.Int64()
+		parentPositionID, _ := r.ParentPositionID.Int64()
+		duration, _ := r.Duration.Int64()
+		accrualDays, _ := r.AccrualDays.Int64()
+		amount, _ := r.Amount.Float64()
+		apy, _ := r.APY.Float64()
+		rewardAmount, _ := r.RewardAmt.Float64()
+		extraAPR, _ := r.ExtraRewardAPR.Float64()
+		estExtraReward, _ := r.EstExtraRewardAmt.Float64()
+		nextPay, _ := r.NextPay.Float64()
+		redeemEarly, _ := r.RedeemAmountEarly.Float64()
+		redeeming, _ := r.RedeemingAmt.Float64()
+		lpl.Positions = append(lpl.Positions, &LockedEarnPosition{
+			PositionID:           positionID,
+			ParentPositionID:     parentPositionID,
+			ProjectID:            r.ProjectID,
+			Asset:                r.Asset,
+			Amount:               amount,
+			PurchaseTime:         purchaseTime,
+			Duration:             int(duration),
+			AccrualDays:          int(accrualDays),
+			RewardAsset:          r.RewardAsset,
+			APY:                  apy,
+			RewardAmount:         rewardAmount,
+			ExtraRewardAsset:     r.ExtraRewardAsset,
+			ExtraRewardAPR:       extraAPR,
+			EstExtraRewardAmount: estExtraReward,
+			NextPay:              nextPay,
+			NextPayDate:          nextPayDate,
+			RedeemAmountEarly:    redeemEarly,
+			RewardsEndDate:       rewardsEndDate,
+			DeliverDate:          deliverDate,
+			RedeemingAmount:      redeeming,
+			RedeemTo:             r.RedeemTo,
+			CanRedeemEarly:       r.CanRedeemEarly,
+			CanFastRedemption:    r.CanFastRedemption,
+			AutoSubscribe:        r.AutoSubscribe,
+			Type:                 r.Type,
+			Status:               r.Status,
+		})
+	}
+	return lpl, nil
+}
+
+func (as *apiService) FlexibleEarnRewards(frr FlexibleEarnRewardsRequest) (*FlexibleEarnRewardHistory, error) {
+	params := make(map[string]string)
+	params["type"] = string(frr.Type)
+	params["timestamp"] = strconv.FormatInt(unixMillis(frr.Timestamp), 10)
+	if frr.ProductID != "" {
+		params["productId"] = frr.ProductID
+	}
+	if frr.Asset != "" {
+		params["asset"] = frr.Asset
+	}
+	if !frr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(frr.StartTime), 10)
+	}
+	if !frr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(frr.EndTime), 10)
+	}
+	if frr.Current != 0 {
+		params["current"] = strconv.Itoa(frr.Current)
+	}
+	if frr.Size != 0 {
+		params["size"] = strconv.Itoa(frr.Size)
+	}
+	if frr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(frr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/simple-earn/flexible/history/rewardsRecord", params)
+	if err != nil {
+		return nil, err
+	}
+	return flexibleEarnRewardsFromRaw(textRes)
+}
+
+func flexibleEarnRewardsFromRaw(textRes []byte) (*FlexibleEarnRewardHistory, error) {
+	rawHistory := struct {
+		Total int `json:"total"`
+		Rows  []struct {
+			ProjectID string         `json:"projectId"`
+			Asset     string         `json:"asset"`
+			Rewards   json.Number    `json:"rewards"`
+			Type      EarnRewardType `json:"type"`
+			Time      json.Number    `json:"time"`
+		} `json:"rows"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawHistory); err != nil {
+		return nil, errors.Wrap(err, "rawHistory unmarshal failed")
+	}
+
+	frh := &FlexibleEarnRewardHistory{
+		Total: rawHistory.Total,
+	}
+	for _, r := range rawHistory.Rows {
+		t, err := timeFromUnixTimestampNumber(r.Time)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse FlexibleEarnReward.Time")
+		}
+		rewards, _ := r.Rewards.Float64()
+		frh.Rewards = append(frh.Rewards, &FlexibleEarnReward{
+			ProductID: r.ProjectID,
+			Asset:     r.Asset,
+			Rewards:   rewards,
+			Type:      r.Type,
+			Time:      t,
+		})
+	}
+	return frh, nil
+}
+
+func (as *apiService) LockedEarnRewards(lrr LockedEarnRewardsRequest) (*LockedEarnRewardHistory, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(lrr.Timestamp), 10)
+	if lrr.PositionID != 0 {
+		params["positionId"] = strconv.FormatInt(lrr.PositionID, 10)
+	}
+	if lrr.Asset != "" {
+		params["asset"] = lrr.Asset
+	}
+	if !lrr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(lrr.StartTime), 10)
+	}
+	if !lrr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(lrr.EndTime), 10)
+	}
+	if lrr.Current != 0 {
+		params["current"] = strconv.Itoa(lrr.Current)
+	}
+	if lrr.Size != 0 {
+		params["size"] = strconv.Itoa(lrr.Size)
+	}
+	if lrr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(lrr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/simple-earn/locked/history/rewardsRecord", params)
+	if err != nil {
+		return nil, err
+	}
+	return lockedEarnRewardsFromRaw(textRes)
+}
+
+func lockedEarnRewardsFromRaw(textRes []byte) (*LockedEarnRewardHistory, error) {
+	rawHistory := struct {
+		Total int `json:"total"`
+		Rows  []struct {
+			PositionID json.Number `json:"positionId"`
+			Asset      string      `json:"asset"`
+			Amount     json.Number `json:"amount"`
+			LockPeriod json.Number `json:"lockPeriod"`
+			Time       json.Number `json:"time"`
+		} `json:"rows"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawHistory); err != nil {
+		return nil, errors.Wrap(err, "rawHistory unmarshal failed")
+	}
+
+	lrh := &LockedEarnRewardHistory{
+		Total: rawHistory.Total,
+	}
+	for _, r := range rawHistory.Rows {
+		t, err := timeFromUnixTimestampNumber(r.Time)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse LockedEarnReward.Time")
+		}
+		positionID, _ := r.PositionID.Int64()
+		amount, _ := r.Amount.Float64()
+		lockPeriod, _ := r.LockPeriod.Int64()
+		lrh.Rewards = append(lrh.Rewards, &LockedEarnReward{
+			PositionID: positionID,
+			Asset:      r.Asset,
+			Amount:     amount,
+			LockPeriod: int(lockPeriod),
+			Time:       t,
+		})
+	}
+	return lrh, nil
+}
+
+func earnProductsParams(epr EarnProductsRequest) map[string]string {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(epr.Timestamp), 10)
+	if epr.Asset != "" {
+		params["asset"] = epr.Asset
+	}
+	if epr.Current != 0 {
+		params["current"] = strconv.Itoa(epr.Current)
+	}
+	if epr.Size != 0 {
+		params["size"] = strconv.Itoa(epr.Size)
+	}
+	if epr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(epr.RecvWindow), 10)
+	}
+	return params
+}
+
+func tierRates(raw map[string]json.Number) map[string]float64 {
+	if raw == nil {
+		return nil
+	}
+	rates := make(map[string]float64, len(raw))
+	for tier, r := range raw {
+		rates[tier], _ = r.Float64()
+	}
+	return rates
+}
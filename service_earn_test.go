@@ -0,0 +1,101 @@
+package binance
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFlexibleEarnProductsFromRaw(t *testing.T) {
+	textRes := []byte(`{"rows":[{"asset":"BTC","latestAnnualPercentageRate":"0.05000000",
+		"tierAnnualPercentageRate":{"0-5BTC":0.05,"5-10BTC":0.03},"airDropPercentageRate":"0.05000000",
+		"canPurchase":true,"canRedeem":true,"isSoldOut":true,"hot":true,"minPurchaseAmount":"0.01000000",
+		"productId":"BTC001","subscriptionStartTime":"1646182276000","status":"PURCHASING"}],"total":1}`)
+	fpl, err := flexibleEarnProductsFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fpl.Total != 1 || len(fpl.Products) != 1 {
+		t.Fatalf("unexpected products: %#v", fpl)
+	}
+	p := fpl.Products[0]
+	if p.ProductID != "BTC001" || p.LatestAnnualPercentageRate != 0.05 || p.TierAnnualPercentageRate["5-10BTC"] != 0.03 ||
+		p.MinPurchaseAmount != 0.01 || !p.SubscriptionStartTime.Equal(time.Unix(1646182276, 0)) {
+		t.Errorf("unexpected product: %#v", p)
+	}
+}
+
+func TestLockedEarnProductsFromRaw(t *testing.T) {
+	textRes := []byte(`{"rows":[{"projectId":"Axs*90","detail":{"asset":"AXS","rewardAsset":"AXS","duration":90,
+		"renewable":true,"isSoldOut":true,"apr":"1.2069","status":"CREATED","subscriptionStartTime":"1646182276000",
+		"extraRewardAsset":"BNB","extraRewardAPR":"0.23"},"quota":{"totalPersonalQuota":"2","minimum":"0.001"}}],"total":1}`)
+	lpl, err := lockedEarnProductsFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lpl.Products) != 1 {
+		t.Fatalf("unexpected products: %#v", lpl)
+	}
+	p := lpl.Products[0]
+	if p.ProjectID != "Axs*90" || p.Duration != 90 || p.APR != 1.2069 || p.ExtraRewardAPR != 0.23 ||
+		p.TotalPersonalQuota != 2 || p.Minimum != 0.001 {
+		t.Errorf("unexpected product: %#v", p)
+	}
+}
+
+func TestEarnSubscriptionFromRaw(t *testing.T) {
+	es, err := earnSubscriptionFromRaw([]byte(`{"purchaseId":40607,"positionId":"12345","success":true}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if es.PurchaseID != 40607 || es.PositionID != 12345 || !es.Success {
+		t.Errorf("unexpected subscription: %#v", es)
+	}
+}
+
+func TestLockedEarnPositionsFromRaw(t *testing.T) {
+	textRes := []byte(`{"rows":[{"positionId":123123,"parentPositionId":123122,"projectId":"Axs*90","asset":"AXS",
+		"amount":"122.09202928","purchaseTime":"1646182276000","duration":"60","accrualDays":"4","rewardAsset":"AXS",
+		"APY":"0.2032","rewardAmt":"5.17181528","extraRewardAsset":"BNB","extraRewardAPR":"0.0203",
+		"estExtraRewardAmt":"5.17181528","nextPay":"1.29295383","nextPayDate":"1646697600000","payPeriod":"1",
+		"redeemAmountEarly":"2802.24068892","rewardsEndDate":"1651449600000","deliverDate":"1651536000000",
+		"redeemPeriod":"1","redeemingAmt":"232.2323","redeemTo":"FLEXIBLE","partialAmtDeliverDate":"1651536000000",
+		"canRedeemEarly":true,"canFastRedemption":true,"autoSubscribe":true,"type":"AUTO","status":"HOLDING",
+		"canReStake":true}],"total":1}`)
+	lpl, err := lockedEarnPositionsFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lpl.Positions) != 1 {
+		t.Fatalf("unexpected positions: %#v", lpl)
+	}
+	p := lpl.Positions[0]
+	if p.PositionID != 123123 || p.ParentPositionID != 123122 || p.Amount != 122.09202928 || p.Duration != 60 ||
+		p.AccrualDays != 4 || p.APY != 0.2032 || p.RedeemTo != EarnRedeemToFlexible {
+		t.Errorf("unexpected position: %#v", p)
+	}
+	if !p.DeliverDate.Equal(time.Unix(1651536000, 0)) || !p.NextPayDate.Equal(time.Unix(1646697600, 0)) {
+		t.Errorf("unexpected position dates: %#v", p)
+	}
+}
+
+func TestEarnRewardsFromRaw(t *testing.T) {
+	frh, err := flexibleEarnRewardsFromRaw([]byte(`{"rows":[{"asset":"BUSD","rewards":"0.00006408",
+		"projectId":"USDT001","type":"BONUS","time":1577233578000}],"total":1}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frh.Rewards) != 1 || frh.Rewards[0].ProductID != "USDT001" || frh.Rewards[0].Rewards != 0.00006408 ||
+		frh.Rewards[0].Type != EarnRewardBonus {
+		t.Errorf("unexpected flexible rewards: %#v", frh)
+	}
+
+	lrh, err := lockedEarnRewardsFromRaw([]byte(`{"rows":[{"positionId":"123123","time":1646182276000,
+		"asset":"AXS","lockPeriod":"30","amount":"21312.23223"}],"total":1}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lrh.Rewards) != 1 || lrh.Rewards[0].PositionID != 123123 || lrh.Rewards[0].LockPeriod != 30 ||
+		lrh.Rewards[0].Amount != 21312.23223 {
+		t.Errorf("unexpected locked rewards: %#v", lrh)
+	}
+}
@@ -0,0 +1,154 @@
+package binance
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+type rawCommissionRates struct {
+	Maker  string `json:"maker"`
+	Taker  string `json:"taker"`
+	Buyer  string `json:"buyer"`
+	Seller string `json:"seller"`
+}
+
+func (rcr *rawCommissionRates) commissionRates() (*CommissionRates, error) {
+	cr := &CommissionRates{}
+	for _, f := range []struct {
+		raw  string
+		dest *float64
+	}{
+		{rcr.Maker, &cr.Maker},
+		{rcr.Taker, &cr.Taker},
+		{rcr.Buyer, &cr.Buyer},
+		{rcr.Seller, &cr.Seller},
+	} {
+		if f.raw == "" {
+			continue
+		}
+		v, err := floatFromString(f.raw)
+		if err != nil {
+			return nil, err
+		}
+		*f.dest = v
+	}
+	return cr, nil
+}
+
+func (as *apiService) TradeFee(tfr TradeFeeRequest) ([]*TradeFee, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(tfr.Timestamp), 10)
+	if tfr.Symbol != "" {
+		params["symbol"] = tfr.Symbol
+	}
+	if tfr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(tfr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/asset/tradeFee", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return tradeFeesFromRaw(textRes)
+}
+
+func tradeFeesFromRaw(textRes []byte) ([]*TradeFee, error) {
+	rawFees := []struct {
+		Symbol          string `json:"symbol"`
+		MakerCommission string `json:"makerCommission"`
+		TakerCommission string `json:"takerCommission"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawFees); err != nil {
+		return nil, errors.Wrap(err, "rawFees unmarshal failed")
+	}
+
+	var tfc []*TradeFee
+	for _, rf := range rawFees {
+		maker, err := floatFromString(rf.MakerCommission)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse TradeFee.MakerCommission")
+		}
+		taker, err := floatFromString(rf.TakerCommission)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse TradeFee.TakerCommission")
+		}
+		tfc = append(tfc, &TradeFee{
+			Symbol:          rf.Symbol,
+			MakerCommission: maker,
+			TakerCommission: taker,
+		})
+	}
+	return tfc, nil
+}
+
+func (as *apiService) Commission(cr CommissionRequest) (*AccountCommission, error) {
+	params := make(map[string]string)
+	params["symbol"] = cr.Symbol
+	params["timestamp"] = strconv.FormatInt(unixMillis(cr.Timestamp), 10)
+	if cr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(cr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "api/v3/account/commission", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountCommissionFromRaw(textRes)
+}
+
+func accountCommissionFromRaw(textRes []byte) (*AccountCommission, error) {
+	rawCommission := struct {
+		Symbol             string              `json:"symbol"`
+		StandardCommission *rawCommissionRates `json:"standardCommission"`
+		SpecialCommission  *rawCommissionRates `json:"specialCommission"`
+		TaxCommission      *rawCommissionRates `json:"taxCommission"`
+		Discount           *struct {
+			EnabledForAccount bool   `json:"enabledForAccount"`
+			EnabledForSymbol  bool   `json:"enabledForSymbol"`
+			DiscountAsset     string `json:"discountAsset"`
+			Discount          string `json:"discount"`
+		} `json:"discount"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawCommission); err != nil {
+		return nil, errors.Wrap(err, "rawCommission unmarshal failed")
+	}
+
+	ac := &AccountCommission{
+		Symbol: rawCommission.Symbol,
+	}
+	for _, f := range []struct {
+		name string
+		raw  *rawCommissionRates
+		dest *CommissionRates
+	}{
+		{"Standard", rawCommission.StandardCommission, &ac.Standard},
+		{"Special", rawCommission.SpecialCommission, &ac.Special},
+		{"Tax", rawCommission.TaxCommission, &ac.Tax},
+	} {
+		if f.raw == nil {
+			continue
+		}
+		cr, err := f.raw.commissionRates()
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot parse AccountCommission.%s", f.name)
+		}
+		*f.dest = *cr
+	}
+	if rd := rawCommission.Discount; rd != nil {
+		discount, err := floatFromString(rd.Discount)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse AccountCommission.Discount")
+		}
+		ac.Discount = &CommissionDiscount{
+			EnabledForAccount: rd.EnabledForAccount,
+			EnabledForSymbol:  rd.EnabledForSymbol,
+			DiscountAsset:     rd.DiscountAsset,
+			Discount:          discount,
+		}
+	}
+	return ac, nil
+}
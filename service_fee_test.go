@@ -0,0 +1,52 @@
+package binance
+
+import (
+	"testing"
+)
+
+func TestTradeFeesFromRaw(t *testing.T) {
+	textRes := []byte(`[{"symbol":"ADABNB","makerCommission":"0.001","takerCommission":"0.001"},
+		{"symbol":"BNBBTC","makerCommission":"0.0009","takerCommission":"0.00075"}]`)
+	tfc, err := tradeFeesFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tfc) != 2 || tfc[1].Symbol != "BNBBTC" || tfc[1].MakerCommission != 0.0009 || tfc[1].TakerCommission != 0.00075 {
+		t.Errorf("unexpected trade fees: %#v", tfc)
+	}
+}
+
+func TestAccountCommissionFromRaw(t *testing.T) {
+	textRes := []byte(`{"symbol":"BTCUSDT",
+		"standardCommission":{"maker":"0.00000010","taker":"0.00000020","buyer":"0.00000030","seller":"0.00000040"},
+		"specialCommission":{"maker":"0.01000000","taker":"0.02000000","buyer":"0.03000000","seller":"0.04000000"},
+		"taxCommission":{"maker":"0.00000112","taker":"0.00000114","buyer":"0.00000118","seller":"0.00000116"},
+		"discount":{"enabledForAccount":true,"enabledForSymbol":true,"discountAsset":"BNB","discount":"0.75000000"}}`)
+	ac, err := accountCommissionFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ac.Symbol != "BTCUSDT" || ac.Standard.Seller != 0.0000004 || ac.Special.Taker != 0.02 || ac.Tax.Buyer != 0.00000118 {
+		t.Errorf("unexpected commission: %#v", ac)
+	}
+	if ac.Discount == nil || !ac.Discount.EnabledForSymbol || ac.Discount.DiscountAsset != "BNB" || ac.Discount.Discount != 0.75 {
+		t.Errorf("unexpected discount: %#v", ac.Discount)
+	}
+}
+
+func TestAccountFromRaw(t *testing.T) {
+	textRes := []byte(`{"makerCommission":15,"takerCommission":15,"buyerCommission":0,"sellerCommission":0,
+		"commissionRates":{"maker":"0.00150000","taker":"0.00150000","buyer":"0.00000000","seller":"0.00000000"},
+		"canTrade":true,"canWithdraw":true,"canDeposit":true,
+		"balances":[{"asset":"BTC","free":"4723846.89208129","locked":"0.00000000"}]}`)
+	acc, err := accountFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acc.MakerCommision != 15 || acc.CommissionRates == nil || acc.CommissionRates.Maker != 0.0015 {
+		t.Errorf("unexpected account: %#v", acc)
+	}
+	if len(acc.Balances) != 1 || acc.Balances[0].Free != 4723846.89208129 {
+		t.Errorf("unexpected balances: %#v", acc.Balances)
+	}
+}
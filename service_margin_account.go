@@ -2,42 +2,18 @@ package binance
 
 import (
 	"encoding/json"
-	"github.com/pkg/errors"
 	"io/ioutil"
 	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
 )
 
 func (as *apiService) NewMarginOrder(or NewMarginOrderRequest) (*ProcessedOrder, error) {
-	params := make(map[string]string)
-	params["symbol"] = or.Symbol
-	params["side"] = string(or.Side)
-	params["type"] = string(or.Type)
-	params["quantity"] = strconv.FormatFloat(or.Quantity, 'f', -1, 64)
-	if or.Price > 0.0 {
-		params["price"] = strconv.FormatFloat(or.Price, 'f', -1, 64)
-	}
-	if or.StopPrice != 0 {
-		params["stopPrice"] = strconv.FormatFloat(or.StopPrice, 'f', -1, 64)
-	}
-	if or.NewClientOrderID != "" {
-		params["newClientOrderId"] = or.NewClientOrderID
-	}
-	if or.IcebergQty != 0 {
-		params["icebergQty"] = strconv.FormatFloat(or.IcebergQty, 'f', -1, 64)
-	}
-	if or.SideEffectType != "" {
-		params["sideEffectType"] = string(or.SideEffectType)
-	}
-	if or.TimeInForce != "" {
-		params["timeInForce"] = string(or.TimeInForce)
-	}
+	params := marginOrderParams(or)
 	if or.NewOrderRespType != "" {
 		params["newOrderRespType"] = string(or.NewOrderRespType)
 	}
-	if or.IsIsolated {
-		params["isIsolated"] = "TRUE"
-	}
-	params["timestamp"] = strconv.FormatInt(unixMillis(or.Timestamp), 10)
 
 	res, err := as.request("POST", "sapi/v1/margin/order", params, true, true)
 	if err != nil {
@@ -50,7 +26,7 @@ func (as *apiService) NewMarginOrder(or NewMarginOrderRequest) (*ProcessedOrder,
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawOrder := struct {
@@ -98,7 +74,7 @@ func (as *apiService) NewMarginOrder(or NewMarginOrderRequest) (*ProcessedOrder,
 	}, nil
 }
 
-func (as *apiService) NewMarginOrderTest(or NewMarginOrderRequest) error {
+func marginOrderParams(or NewMarginOrderRequest) map[string]string {
 	params := make(map[string]string)
 	params["symbol"] = or.Symbol
 	params["side"] = string(or.Side)
@@ -122,10 +98,24 @@ func (as *apiService) NewMarginOrderTest(or NewMarginOrderRequest) error {
 	if or.TimeInForce != "" {
 		params["timeInForce"] = string(or.TimeInForce)
 	}
+	if or.SelfTradePreventionMode != "" {
+		params["selfTradePreventionMode"] = string(or.SelfTradePreventionMode)
+	}
+	if or.AutoRepayAtCancel != nil {
+		params["autoRepayAtCancel"] = strings.ToUpper(strconv.FormatBool(*or.AutoRepayAtCancel))
+	}
 	if or.IsIsolated {
 		params["isIsolated"] = "TRUE"
 	}
 	params["timestamp"] = strconv.FormatInt(unixMillis(or.Timestamp), 10)
+	if or.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(or.RecvWindow), 10)
+	}
+	return params
+}
+
+func (as *apiService) NewMarginOrderTest(or NewMarginOrderRequest) error {
+	params := marginOrderParams(or)
 
 	res, err := as.request("POST", "sapi/v1/margin/order/test", params, true, true)
 	if err != nil {
@@ -138,7 +128,7 @@ func (as *apiService) NewMarginOrderTest(or NewMarginOrderRequest) error {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return as.handleError(textRes)
+		return as.handleError(res.StatusCode, textRes)
 	}
 	return nil
 }
@@ -171,7 +161,7 @@ func (as *apiService) QueryMarginOrder(qor QueryOrderRequest) (*ExecutedOrder, e
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawOrder := &rawExecutedOrder{}
@@ -217,7 +207,7 @@ func (as *apiService) CancelMarginOrder(cor CancelOrderRequest) (*CanceledOrder,
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawCanceledOrder := struct {
@@ -260,7 +250,7 @@ func (as *apiService) OpenMarginOrders(oor OpenOrdersRequest) ([]*ExecutedOrder,
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawOrders := []*rawExecutedOrder{}
@@ -290,6 +280,12 @@ func (as *apiService) AllMarginOrders(aor AllOrdersRequest) ([]*ExecutedOrder, e
 	if aor.OrderID != 0 {
 		params["orderId"] = strconv.FormatInt(aor.OrderID, 10)
 	}
+	if !aor.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(aor.StartTime), 10)
+	}
+	if !aor.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(aor.EndTime), 10)
+	}
 	if aor.Limit != 0 {
 		params["limit"] = strconv.Itoa(aor.Limit)
 	}
@@ -308,7 +304,7 @@ func (as *apiService) AllMarginOrders(aor AllOrdersRequest) ([]*ExecutedOrder, e
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawOrders := []*rawExecutedOrder{}
@@ -329,16 +325,15 @@ func (as *apiService) AllMarginOrders(aor AllOrdersRequest) ([]*ExecutedOrder, e
 }
 
 func (as *apiService) MarginAccount(ar AccountRequest) (*MarginAccount, error) {
+	if ar.IsIsolated {
+		return nil, errors.New("isolated margin account is returned by IsolatedMarginAccount")
+	}
 	params := make(map[string]string)
 	params["timestamp"] = strconv.FormatInt(ar.Timestamp.Unix()*1000, 10)
 	if ar.RecvWindow != 0 {
 		params["recvWindow"] = strconv.FormatInt(recvWindow(ar.RecvWindow), 10)
 	}
-	endpoint := "sapi/v1/margin/account"
-	if ar.IsIsolated {
-		endpoint = "sapi/v1/margin/isolated/account"
-	}
-	res, err := as.request("GET", endpoint, params, true, true)
+	res, err := as.request("GET", "sapi/v1/margin/account", params, true, true)
 	if err != nil {
 		return nil, err
 	}
@@ -349,7 +344,7 @@ func (as *apiService) MarginAccount(ar AccountRequest) (*MarginAccount, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawAccount := struct {
@@ -368,25 +363,6 @@ func (as *apiService) MarginAccount(ar AccountRequest) (*MarginAccount, error) {
 			Locked   json.Number `json:"locked"`
 			NetAsset json.Number `json:"netAsset"`
 		} `json:"userAssets"`
-		Assets []struct {
-			Symbol struct {
-				Asset    string      `json:"asset"`
-				Borrowed json.Number `json:"borrowed"`
-				Free     json.Number `json:"free"`
-				Interest json.Number `json:"interest"`
-				Locked   json.Number `json:"locked"`
-				NetAsset json.Number `json:"netAsset"`
-			} `json:"baseAsset"`
-			BaseSymbol struct {
-				Asset    string      `json:"asset"`
-				Borrowed json.Number `json:"borrowed"`
-				Free     json.Number `json:"free"`
-				Interest json.Number `json:"interest"`
-				Locked   json.Number `json:"locked"`
-				NetAsset json.Number `json:"netAsset"`
-			} `json:"quoteAsset"`
-			MarginLevel json.Number `json:"marginLevel"`
-		} `json:"assets"`
 	}{}
 	if err := json.Unmarshal(textRes, &rawAccount); err != nil {
 		return nil, errors.Wrap(err, "rawAccount unmarshal failed")
@@ -420,39 +396,193 @@ func (as *apiService) MarginAccount(ar AccountRequest) (*MarginAccount, error) {
 			NetAsset: netAsset,
 		})
 	}
-	for _, b := range rawAccount.Assets {
-		borrowed, _ := b.Symbol.Borrowed.Float64()
-		free, _ := b.Symbol.Free.Float64()
-		interest, _ := b.Symbol.Interest.Float64()
-		locked, _ := b.Symbol.Locked.Float64()
-		netAsset, _ := b.Symbol.NetAsset.Float64()
-		acc.Assets = append(acc.Assets, &Asset{
-			Asset:    b.Symbol.Asset,
-			Borrowed: borrowed,
-			Free:     free,
-			Interest: interest,
-			Locked:   locked,
-			NetAsset: netAsset,
-		})
 
-		borrowed, _ = b.BaseSymbol.Borrowed.Float64()
-		free, _ = b.BaseSymbol.Free.Float64()
-		interest, _ = b.BaseSymbol.Interest.Float64()
-		locked, _ = b.BaseSymbol.Locked.Float64()
-		netAsset, _ = b.BaseSymbol.NetAsset.Float64()
-		acc.Assets = append(acc.Assets, &Asset{
-			Asset:    b.BaseSymbol.Asset,
-			Borrowed: borrowed,
-			Free:     free,
-			Interest: interest,
-			Locked:   locked,
-			NetAsset: netAsset,
-		})
+	return acc, nil
+}
+
+func (as *apiService) IsolatedMarginAccount(imar IsolatedMarginAccountRequest) (*IsolatedMarginAccount, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(imar.Timestamp), 10)
+	if len(imar.Symbols) > 0 {
+		params["symbols"] = strings.Join(imar.Symbols, ",")
 	}
+	if imar.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(imar.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/margin/isolated/account", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return isolatedMarginAccountFromRaw(textRes)
+}
+
+type rawIsolatedMarginAsset struct {
+	Asset         string      `json:"asset"`
+	BorrowEnabled bool        `json:"borrowEnabled"`
+	RepayEnabled  bool        `json:"repayEnabled"`
+	Borrowed      json.Number `json:"borrowed"`
+	Free          json.Number `json:"free"`
+	Interest      json.Number `json:"interest"`
+	Locked        json.Number `json:"locked"`
+	NetAsset      json.Number `json:"netAsset"`
+	NetAssetOfBtc json.Number `json:"netAssetOfBtc"`
+	TotalAsset    json.Number `json:"totalAsset"`
+}
 
+func isolatedMarginAccountFromRaw(textRes []byte) (*IsolatedMarginAccount, error) {
+	rawAccount := struct {
+		TotalAssetOfBtc     json.Number `json:"totalAssetOfBtc"`
+		TotalLiabilityOfBtc json.Number `json:"totalLiabilityOfBtc"`
+		TotalNetAssetOfBtc  json.Number `json:"totalNetAssetOfBtc"`
+		Assets              []struct {
+			Symbol            string                 `json:"symbol"`
+			BaseAsset         rawIsolatedMarginAsset `json:"baseAsset"`
+			QuoteAsset        rawIsolatedMarginAsset `json:"quoteAsset"`
+			IsolatedCreated   bool                   `json:"isolatedCreated"`
+			Enabled           bool                   `json:"enabled"`
+			TradeEnabled      bool                   `json:"tradeEnabled"`
+			MarginLevel       json.Number            `json:"marginLevel"`
+			MarginLevelStatus string                 `json:"marginLevelStatus"`
+			MarginRatio       json.Number            `json:"marginRatio"`
+			IndexPrice        json.Number            `json:"indexPrice"`
+			LiquidatePrice    json.Number            `json:"liquidatePrice"`
+			LiquidateRate     json.Number            `json:"liquidateRate"`
+		} `json:"assets"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawAccount); err != nil {
+		return nil, errors.Wrap(err, "rawAccount unmarshal failed")
+	}
+
+	totalAssetOfBtc, _ := rawAccount.TotalAssetOfBtc.Float64()
+	totalLiabilityOfBtc, _ := rawAccount.TotalLiabilityOfBtc.Float64()
+	totalNetAssetOfBtc, _ := rawAccount.TotalNetAssetOfBtc.Float64()
+	acc := &IsolatedMarginAccount{
+		TotalAssetOfBtc:     totalAssetOfBtc,
+		TotalLiabilityOfBtc: totalLiabilityOfBtc,
+		TotalNetAssetOfBtc:  totalNetAssetOfBtc,
+	}
+	for _, p := range rawAccount.Assets {
+		marginLevel, _ := p.MarginLevel.Float64()
+		marginRatio, _ := p.MarginRatio.Float64()
+		indexPrice, _ := p.IndexPrice.Float64()
+		liquidatePrice, _ := p.LiquidatePrice.Float64()
+		liquidateRate, _ := p.LiquidateRate.Float64()
+		acc.Pairs = append(acc.Pairs, &IsolatedMarginPair{
+			Symbol:            p.Symbol,
+			Base:              isolatedMarginAssetFromRaw(p.BaseAsset),
+			Quote:             isolatedMarginAssetFromRaw(p.QuoteAsset),
+			IsolatedCreated:   p.IsolatedCreated,
+			Enabled:           p.Enabled,
+			TradeEnabled:      p.TradeEnabled,
+			MarginLevel:       marginLevel,
+			MarginLevelStatus: p.MarginLevelStatus,
+			MarginRatio:       marginRatio,
+			IndexPrice:        indexPrice,
+			LiquidatePrice:    liquidatePrice,
+			LiquidateRate:     liquidateRate,
+		})
+	}
 	return acc, nil
 }
 
+func isolatedMarginAssetFromRaw(ra rawIsolatedMarginAsset) *IsolatedMarginAsset {
+	borrowed, _ := ra.Borrowed.Float64()
+	free, _ := ra.Free.Float64()
+	interest, _ := ra.Interest.Float64()
+	locked, _ := ra.Locked.Float64()
+	netAsset, _ := ra.NetAsset.Float64()
+	netAssetOfBtc, _ := ra.NetAssetOfBtc.Float64()
+	totalAsset, _ := ra.TotalAsset.Float64()
+	return &IsolatedMarginAsset{
+		Asset:         ra.Asset,
+		BorrowEnabled: ra.BorrowEnabled,
+		RepayEnabled:  ra.RepayEnabled,
+		Borrowed:      borrowed,
+		Free:          free,
+		Interest:      interest,
+		Locked:        locked,
+		NetAsset:      netAsset,
+		NetAssetOfBtc: netAssetOfBtc,
+		TotalAsset:    totalAsset,
+	}
+}
+
+func (as *apiService) EnableIsolatedMarginAccount(isr IsolatedSymbolRequest) error {
+	return as.isolatedMarginAccountSwitch("POST", isr)
+}
+
+func (as *apiService) DisableIsolatedMarginAccount(isr IsolatedSymbolRequest) error {
+	return as.isolatedMarginAccountSwitch("DELETE", isr)
+}
+
+func (as *apiService) isolatedMarginAccountSwitch(method string, isr IsolatedSymbolRequest) error {
+	params := make(map[string]string)
+	params["symbol"] = isr.Symbol
+	params["timestamp"] = strconv.FormatInt(unixMillis(isr.Timestamp), 10)
+	if isr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(isr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest(method, "sapi/v1/margin/isolated/account", params)
+	if err != nil {
+		return err
+	}
+
+	rawResult := struct {
+		Success bool `json:"success"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawResult); err != nil {
+		return errors.Wrap(err, "rawResult unmarshal failed")
+	}
+	if !rawResult.Success {
+		return errors.Errorf("isolated margin account of %s not switched", isr.Symbol)
+	}
+	return nil
+}
+
+func (as *apiService) AllIsolatedMarginSymbols(isr IsolatedSymbolRequest) ([]*IsolatedMarginSymbol, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(isr.Timestamp), 10)
+	if isr.Symbol != "" {
+		params["symbol"] = isr.Symbol
+	}
+	if isr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(isr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/margin/isolated/allPairs", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawSymbols []struct {
+		Symbol        string `json:"symbol"`
+		Base          string `json:"base"`
+		Quote         string `json:"quote"`
+		IsMarginTrade bool   `json:"isMarginTrade"`
+		IsBuyAllowed  bool   `json:"isBuyAllowed"`
+		IsSellAllowed bool   `json:"isSellAllowed"`
+	}
+	if err := json.Unmarshal(textRes, &rawSymbols); err != nil {
+		return nil, errors.Wrap(err, "rawSymbols unmarshal failed")
+	}
+
+	var imsc []*IsolatedMarginSymbol
+	for _, rs := range rawSymbols {
+		imsc = append(imsc, &IsolatedMarginSymbol{
+			Symbol:        rs.Symbol,
+			Base:          rs.Base,
+			Quote:         rs.Quote,
+			IsMarginTrade: rs.IsMarginTrade,
+			IsBuyAllowed:  rs.IsBuyAllowed,
+			IsSellAllowed: rs.IsSellAllowed,
+		})
+	}
+	return imsc, nil
+}
+
 func (as *apiService) MyMarginTrades(mtr MyTradesRequest) ([]*Trade, error) {
 	params := make(map[string]string)
 	params["symbol"] = mtr.Symbol
@@ -464,7 +594,13 @@ func (as *apiService) MyMarginTrades(mtr MyTradesRequest) ([]*Trade, error) {
 		params["recvWindow"] = strconv.FormatInt(recvWindow(mtr.RecvWindow), 10)
 	}
 	if mtr.FromID != 0 {
-		params["orderId"] = strconv.FormatInt(mtr.FromID, 10)
+		params["fromId"] = strconv.FormatInt(mtr.FromID, 10)
+	}
+	if !mtr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(mtr.StartTime), 10)
+	}
+	if !mtr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(mtr.EndTime), 10)
 	}
 	if mtr.Limit != 0 {
 		params["limit"] = strconv.Itoa(mtr.Limit)
@@ -481,7 +617,7 @@ func (as *apiService) MyMarginTrades(mtr MyTradesRequest) ([]*Trade, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	var rawTrades []struct {
@@ -553,7 +689,7 @@ func (as *apiService) AllMarginAssets(ar AccountRequest) ([]*MarginAsset, error)
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	var rawAllAssets []struct {
@@ -583,11 +719,11 @@ func (as *apiService) AllMarginAssets(ar AccountRequest) ([]*MarginAsset, error)
 
 }
 
-func (as *apiService) MaxBorrow(mbr MaxMarginRequest) (float64, error) {
+func (as *apiService) MaxBorrow(mbr MaxMarginRequest) (*MaxBorrowable, error) {
 	params := make(map[string]string)
-	params["asset"] = mbr.Symbol
-	if mbr.IsIsolated {
-		params["isolatedSymbol"] = mbr.PairId
+	params["asset"] = mbr.Asset
+	if mbr.IsolatedSymbol != "" {
+		params["isolatedSymbol"] = mbr.IsolatedSymbol
 	}
 	params["timestamp"] = strconv.FormatInt(unixMillis(mbr.Timestamp), 10)
 	if mbr.RecvWindow != 0 {
@@ -596,34 +732,39 @@ func (as *apiService) MaxBorrow(mbr MaxMarginRequest) (float64, error) {
 
 	res, err := as.request("GET", "sapi/v1/margin/maxBorrowable", params, true, true)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	textRes, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		return 0, errors.Wrap(err, "unable to read response from account.maxBorrowable")
+		return nil, errors.Wrap(err, "unable to read response from account.maxBorrowable")
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return 0, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	var rawResult struct {
-		Amount json.Number `json:"amount"`
+		Amount      json.Number `json:"amount"`
+		BorrowLimit json.Number `json:"borrowLimit"`
 	}
 	if err := json.Unmarshal(textRes, &rawResult); err != nil {
-		return 0, errors.Wrap(err, "rawResult unmarshal failed")
+		return nil, errors.Wrap(err, "rawResult unmarshal failed")
 	}
 	amount, _ := rawResult.Amount.Float64()
+	borrowLimit, _ := rawResult.BorrowLimit.Float64()
 
-	return amount, nil
+	return &MaxBorrowable{
+		Amount:      amount,
+		BorrowLimit: borrowLimit,
+	}, nil
 }
 
 func (as *apiService) MaxTransfer(mbr MaxMarginRequest) (float64, error) {
 	params := make(map[string]string)
-	params["asset"] = mbr.Symbol
-	if mbr.IsIsolated {
-		params["isolatedSymbol"] = mbr.PairId
+	params["asset"] = mbr.Asset
+	if mbr.IsolatedSymbol != "" {
+		params["isolatedSymbol"] = mbr.IsolatedSymbol
 	}
 	params["timestamp"] = strconv.FormatInt(unixMillis(mbr.Timestamp), 10)
 	if mbr.RecvWindow != 0 {
@@ -641,7 +782,7 @@ func (as *apiService) MaxTransfer(mbr MaxMarginRequest) (float64, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return 0, as.handleError(textRes)
+		return 0, as.handleError(res.StatusCode, textRes)
 	}
 
 	var rawResult struct {
@@ -654,3 +795,699 @@ func (as *apiService) MaxTransfer(mbr MaxMarginRequest) (float64, error) {
 
 	return amount, nil
 }
+
+func (as *apiService) MarginBorrow(mlr MarginLoanRequest) (*MarginLoanResult, error) {
+	return as.marginBorrowRepay("BORROW", mlr)
+}
+
+func (as *apiService) MarginRepay(mlr MarginLoanRequest) (*MarginLoanResult, error) {
+	return as.marginBorrowRepay("REPAY", mlr)
+}
+
+func (as *apiService) marginBorrowRepay(typ string, mlr MarginLoanRequest) (*MarginLoanResult, error) {
+	params := make(map[string]string)
+	params["type"] = typ
+	params["asset"] = mlr.Asset
+	params["amount"] = strconv.FormatFloat(mlr.Amount, 'f', -1, 64)
+	params["isIsolated"] = "FALSE"
+	if mlr.IsIsolated {
+		params["isIsolated"] = "TRUE"
+		params["symbol"] = mlr.Symbol
+	}
+	params["timestamp"] = strconv.FormatInt(unixMillis(mlr.Timestamp), 10)
+	if mlr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(mlr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/margin/borrow-repay", params)
+	if err != nil {
+		return nil, err
+	}
+
+	rawResult := struct {
+		TranID int64 `json:"tranId"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawResult); err != nil {
+		return nil, errors.Wrap(err, "rawResult unmarshal failed")
+	}
+	return &MarginLoanResult{
+		TranID: rawResult.TranID,
+	}, nil
+}
+
+func (as *apiService) MarginLoanRecords(mlrr MarginLoanRecordsRequest) (*MarginLoanHistory, error) {
+	return as.marginBorrowRepayRecords("BORROW", mlrr)
+}
+
+func (as *apiService) MarginRepayRecords(mlrr MarginLoanRecordsRequest) (*MarginLoanHistory, error) {
+	return as.marginBorrowRepayRecords("REPAY", mlrr)
+}
+
+func (as *apiService) marginBorrowRepayRecords(typ string, mlrr MarginLoanRecordsRequest) (*MarginLoanHistory, error) {
+	params := make(map[string]string)
+	params["type"] = typ
+	params["timestamp"] = strconv.FormatInt(unixMillis(mlrr.Timestamp), 10)
+	if mlrr.Asset != "" {
+		params["asset"] = mlrr.Asset
+	}
+	if mlrr.IsolatedSymbol != "" {
+		params["isolatedSymbol"] = mlrr.IsolatedSymbol
+	}
+	if mlrr.TxID != 0 {
+		params["txId"] = strconv.FormatInt(mlrr.TxID, 10)
+	}
+	if !mlrr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(mlrr.StartTime), 10)
+	}
+	if !mlrr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(mlrr.EndTime), 10)
+	}
+	if mlrr.Current != 0 {
+		params["current"] = strconv.Itoa(mlrr.Current)
+	}
+	if mlrr.Size != 0 {
+		params["size"] = strconv.Itoa(mlrr.Size)
+	}
+	if mlrr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(mlrr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/margin/borrow-repay", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return marginLoanHistoryFromRaw(textRes)
+}
+
+func marginLoanHistoryFromRaw(textRes []byte) (*MarginLoanHistory, error) {
+	rawHistory := struct {
+		Total int `json:"total"`
+		Rows  []struct {
+			TxID           int64          `json:"txId"`
+			Asset          string         `json:"asset"`
+			IsolatedSymbol string         `json:"isolatedSymbol"`
+			Amount         json.Number    `json:"amount"`
+			Principal      json.Number    `json:"principal"`
+			Interest       json.Number    `json:"interest"`
+			Status         TransferStatus `json:"status"`
+			Type           string         `json:"type"`
+			Timestamp      float64        `json:"timestamp"`
+		} `json:"rows"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawHistory); err != nil {
+		return nil, errors.Wrap(err, "rawHistory unmarshal failed")
+	}
+
+	mlh := &MarginLoanHistory{
+		Total: rawHistory.Total,
+	}
+	for _, r := range rawHistory.Rows {
+		t, err := timeFromUnixTimestampFloat(r.Timestamp)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse MarginLoanRecord.Time")
+		}
+		amount, _ := r.Amount.Float64()
+		principal, _ := r.Principal.Float64()
+		interest, _ := r.Interest.Float64()
+		mlh.Records = append(mlh.Records, &MarginLoanRecord{
+			TxID:           r.TxID,
+			Asset:          r.Asset,
+			IsolatedSymbol: r.IsolatedSymbol,
+			Amount:         amount,
+			Principal:      principal,
+			Interest:       interest,
+			Status:         r.Status,
+			Type:           r.Type,
+			Time:           t,
+		})
+	}
+	return mlh, nil
+}
+
+func (as *apiService) MarginInterestHistory(ihr InterestHistoryRequest) (*InterestHistory, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(ihr.Timestamp), 10)
+	if ihr.Asset != "" {
+		params["asset"] = ihr.Asset
+	}
+	if ihr.IsolatedSymbol != "" {
+		params["isolatedSymbol"] = ihr.IsolatedSymbol
+	}
+	if !ihr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(ihr.StartTime), 10)
+	}
+	if !ihr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(ihr.EndTime), 10)
+	}
+	if ihr.Current != 0 {
+		params["current"] = strconv.Itoa(ihr.Current)
+	}
+	if ihr.Size != 0 {
+		params["size"] = strconv.Itoa(ihr.Size)
+	}
+	if ihr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(ihr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/margin/interestHistory", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return interestHistoryFromRaw(textRes)
+}
+
+func interestHistoryFromRaw(textRes []byte) (*InterestHistory, error) {
+	rawHistory := struct {
+		Total int `json:"total"`
+		Rows  []struct {
+			TxID                int64       `json:"txId"`
+			InterestAccuredTime float64     `json:"interestAccuredTime"`
+			Asset               string      `json:"asset"`
+			RawAsset            string      `json:"rawAsset"`
+			Principal           json.Number `json:"principal"`
+			Interest            json.Number `json:"interest"`
+			InterestRate        json.Number `json:"interestRate"`
+			Type                string      `json:"type"`
+			IsolatedSymbol      string      `json:"isolatedSymbol"`
+		} `json:"rows"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawHistory); err != nil {
+		return nil, errors.Wrap(err, "rawHistory unmarshal failed")
+	}
+
+	ih := &InterestHistory{
+		Total: rawHistory.Total,
+	}
+	for _, r := range rawHistory.Rows {
+		t, err := timeFromUnixTimestampFloat(r.InterestAccuredTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse InterestRecord.Time")
+		}
+		principal, _ := r.Principal.Float64()
+		interest, _ := r.Interest.Float64()
+		interestRate, _ := r.InterestRate.Float64()
+		ih.Records = append(ih.Records, &InterestRecord{
+			TxID:           r.TxID,
+			Asset:          r.Asset,
+			RawAsset:       r.RawAsset,
+			IsolatedSymbol: r.IsolatedSymbol,
+			Principal:      principal,
+			Interest:       interest,
+			InterestRate:   interestRate,
+			Type:           r.Type,
+			Time:           t,
+		})
+	}
+	return ih, nil
+}
+
+func (as *apiService) ForceLiquidations(flr ForceLiquidationRequest) (*ForceLiquidationHistory, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(flr.Timestamp), 10)
+	if flr.IsolatedSymbol != "" {
+		params["isolatedSymbol"] = flr.IsolatedSymbol
+	}
+	if !flr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(flr.StartTime), 10)
+	}
+	if !flr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(flr.EndTime), 10)
+	}
+	if flr.Current != 0 {
+		params["current"] = strconv.Itoa(flr.Current)
+	}
+	if flr.Size != 0 {
+		params["size"] = strconv.Itoa(flr.Size)
+	}
+	if flr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(flr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/margin/forceLiquidationRec", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return forceLiquidationsFromRaw(textRes)
+}
+
+func forceLiquidationsFromRaw(textRes []byte) (*ForceLiquidationHistory, error) {
+	rawHistory := struct {
+		Total int `json:"total"`
+		Rows  []struct {
+			OrderID     int64       `json:"orderId"`
+			Symbol      string      `json:"symbol"`
+			Side        OrderSide   `json:"side"`
+			Price       json.Number `json:"price"`
+			AvgPrice    json.Number `json:"avgPrice"`
+			Qty         json.Number `json:"qty"`
+			ExecutedQty json.Number `json:"executedQty"`
+			TimeInForce TimeInForce `json:"timeInForce"`
+			IsIsolated  bool        `json:"isIsolated"`
+			UpdatedTime float64     `json:"updatedTime"`
+		} `json:"rows"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawHistory); err != nil {
+		return nil, errors.Wrap(err, "rawHistory unmarshal failed")
+	}
+
+	flh := &ForceLiquidationHistory{
+		Total: rawHistory.Total,
+	}
+	for _, r := range rawHistory.Rows {
+		t, err := timeFromUnixTimestampFloat(r.UpdatedTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse ForceLiquidation.UpdateTime")
+		}
+		price, _ := r.Price.Float64()
+		avgPrice, _ := r.AvgPrice.Float64()
+		qty, _ := r.Qty.Float64()
+		executedQty, _ := r.ExecutedQty.Float64()
+		flh.Records = append(flh.Records, &ForceLiquidation{
+			OrderID:     r.OrderID,
+			Symbol:      r.Symbol,
+			Side:        r.Side,
+			Price:       price,
+			AvgPrice:    avgPrice,
+			Qty:         qty,
+			ExecutedQty: executedQty,
+			TimeInForce: r.TimeInForce,
+			IsIsolated:  r.IsIsolated,
+			UpdateTime:  t,
+		})
+	}
+	return flh, nil
+}
+
+func (as *apiService) MarginInterestRateHistory(irhr InterestRateHistoryRequest) ([]*InterestRate, error) {
+	params := make(map[string]string)
+	params["asset"] = irhr.Asset
+	params["timestamp"] = strconv.FormatInt(unixMillis(irhr.Timestamp), 10)
+	if irhr.VIPLevel != nil {
+		params["vipLevel"] = strconv.Itoa(*irhr.VIPLevel)
+	}
+	if !irhr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(irhr.StartTime), 10)
+	}
+	if !irhr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(irhr.EndTime), 10)
+	}
+	if irhr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(irhr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/margin/interestRateHistory", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawRates []struct {
+		Asset             string      `json:"asset"`
+		DailyInterestRate json.Number `json:"dailyInterestRate"`
+		Timestamp         float64     `json:"timestamp"`
+		VIPLevel          int         `json:"vipLevel"`
+	}
+	if err := json.Unmarshal(textRes, &rawRates); err != nil {
+		return nil, errors.Wrap(err, "rawRates unmarshal failed")
+	}
+
+	var irc []*InterestRate
+	for _, rr := range rawRates {
+		t, err := timeFromUnixTimestampFloat(rr.Timestamp)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse InterestRate.Time")
+		}
+		rate, _ := rr.DailyInterestRate.Float64()
+		irc = append(irc, &InterestRate{
+			Asset:             rr.Asset,
+			DailyInterestRate: rate,
+			VIPLevel:          rr.VIPLevel,
+			Time:              t,
+		})
+	}
+	return irc, nil
+}
+
+func (as *apiService) CrossMarginCollateralRatio() ([]*CollateralRatio, error) {
+	params := make(map[string]string)
+
+	res, err := as.request("GET", "sapi/v1/margin/crossMarginCollateralRatio", params, true, false)
+	if err != nil {
+		return nil, err
+	}
+	textRes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read response from crossMarginCollateralRatio.get")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, as.handleError(res.StatusCode, textRes)
+	}
+
+	return collateralRatiosFromRaw(textRes)
+}
+
+func collateralRatiosFromRaw(textRes []byte) ([]*CollateralRatio, error) {
+	var rawRatios []struct {
+		Collaterals []struct {
+			MinUSDValue  json.Number `json:"minUsdValue"`
+			MaxUSDValue  json.Number `json:"maxUsdValue"`
+			DiscountRate json.Number `json:"discountRate"`
+		} `json:"collaterals"`
+		AssetNames []string `json:"assetNames"`
+	}
+	if err := json.Unmarshal(textRes, &rawRatios); err != nil {
+		return nil, errors.Wrap(err, "rawRatios unmarshal failed")
+	}
+
+	var crc []*CollateralRatio
+	for _, rr := range rawRatios {
+		cr := &CollateralRatio{
+			Assets: rr.AssetNames,
+		}
+		for _, rc := range rr.Collaterals {
+			min, _ := rc.MinUSDValue.Float64()
+			max, _ := rc.MaxUSDValue.Float64()
+			rate, _ := rc.DiscountRate.Float64()
+			cr.Collaterals = append(cr.Collaterals, &CollateralTier{
+				MinUSDValue:  min,
+				MaxUSDValue:  max,
+				DiscountRate: rate,
+			})
+		}
+		crc = append(crc, cr)
+	}
+	return crc, nil
+}
+
+func (as *apiService) MarginPriceIndex(mpir MarginPriceIndexRequest) (*MarginPriceIndex, error) {
+	params := make(map[string]string)
+	params["symbol"] = mpir.Symbol
+
+	res, err := as.request("GET", "sapi/v1/margin/priceIndex", params, true, false)
+	if err != nil {
+		return nil, err
+	}
+	textRes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read response from priceIndex.get")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, as.handleError(res.StatusCode, textRes)
+	}
+
+	rawIndex := struct {
+		CalcTime float64     `json:"calcTime"`
+		Price    json.Number `json:"price"`
+		Symbol   string      `json:"symbol"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawIndex); err != nil {
+		return nil, errors.Wrap(err, "rawIndex unmarshal failed")
+	}
+	t, err := timeFromUnixTimestampFloat(rawIndex.CalcTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse MarginPriceIndex.CalcTime")
+	}
+	price, _ := rawIndex.Price.Float64()
+
+	return &MarginPriceIndex{
+		Symbol:   rawIndex.Symbol,
+		Price:    price,
+		CalcTime: t,
+	}, nil
+}
+
+func (as *apiService) CancelOpenMarginOrders(oor OpenOrdersRequest) ([]*CanceledOrder, error) {
+	params := make(map[string]string)
+	params["symbol"] = oor.Symbol
+	params["timestamp"] = strconv.FormatInt(unixMillis(oor.Timestamp), 10)
+	if oor.IsIsolated {
+		params["isIsolated"] = "TRUE"
+	}
+	if oor.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(oor.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("DELETE", "sapi/v1/margin/openOrders", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return canceledOrdersFromRaw(textRes)
+}
+
+// canceledOrdersFromRaw flattens canceled orders and orders of canceled order
+// lists.
+func canceledOrdersFromRaw(textRes []byte) ([]*CanceledOrder, error) {
+	type rawCanceledOrder struct {
+		Symbol            string `json:"symbol"`
+		OrigClientOrderID string `json:"origClientOrderId"`
+		OrderID           int64  `json:"orderId"`
+		ClientOrderID     string `json:"clientOrderId"`
+	}
+	var rawCanceled []struct {
+		rawCanceledOrder
+		OrderReports []rawCanceledOrder `json:"orderReports"`
+	}
+	if err := json.Unmarshal(textRes, &rawCanceled); err != nil {
+		return nil, errors.Wrap(err, "rawCanceled unmarshal failed")
+	}
+
+	var coc []*CanceledOrder
+	for _, rc := range rawCanceled {
+		reports := rc.OrderReports
+		if len(reports) == 0 {
+			reports = []rawCanceledOrder{rc.rawCanceledOrder}
+		}
+		for _, r := range reports {
+			coc = append(coc, &CanceledOrder{
+				Symbol:            r.Symbol,
+				OrigClientOrderID: r.OrigClientOrderID,
+				OrderID:           r.OrderID,
+				ClientOrderID:     r.ClientOrderID,
+			})
+		}
+	}
+	return coc, nil
+}
+
+func (as *apiService) NewMarginOCO(nor NewMarginOCORequest) (*OrderList, error) {
+	params := make(map[string]string)
+	params["symbol"] = nor.Symbol
+	params["side"] = string(nor.Side)
+	params["quantity"] = strconv.FormatFloat(nor.Quantity, 'f', -1, 64)
+	params["price"] = strconv.FormatFloat(nor.Price, 'f', -1, 64)
+	params["stopPrice"] = strconv.FormatFloat(nor.StopPrice, 'f', -1, 64)
+	params["timestamp"] = strconv.FormatInt(unixMillis(nor.Timestamp), 10)
+	if nor.IsIsolated {
+		params["isIsolated"] = "TRUE"
+	}
+	if nor.ListClientOrderID != "" {
+		params["listClientOrderId"] = nor.ListClientOrderID
+	}
+	if nor.LimitClientOrderID != "" {
+		params["limitClientOrderId"] = nor.LimitClientOrderID
+	}
+	if nor.LimitIcebergQty != 0 {
+		params["limitIcebergQty"] = strconv.FormatFloat(nor.LimitIcebergQty, 'f', -1, 64)
+	}
+	if nor.StopClientOrderID != "" {
+		params["stopClientOrderId"] = nor.StopClientOrderID
+	}
+	if nor.StopLimitPrice != 0 {
+		params["stopLimitPrice"] = strconv.FormatFloat(nor.StopLimitPrice, 'f', -1, 64)
+	}
+	if nor.StopIcebergQty != 0 {
+		params["stopIcebergQty"] = strconv.FormatFloat(nor.StopIcebergQty, 'f', -1, 64)
+	}
+	if nor.StopLimitTimeInForce != "" {
+		params["stopLimitTimeInForce"] = string(nor.StopLimitTimeInForce)
+	}
+	if nor.NewOrderRespType != "" {
+		params["newOrderRespType"] = string(nor.NewOrderRespType)
+	}
+	if nor.SideEffectType != "" {
+		params["sideEffectType"] = string(nor.SideEffectType)
+	}
+	if nor.SelfTradePreventionMode != "" {
+		params["selfTradePreventionMode"] = string(nor.SelfTradePreventionMode)
+	}
+	if nor.AutoRepayAtCancel != nil {
+		params["autoRepayAtCancel"] = strings.ToUpper(strconv.FormatBool(*nor.AutoRepayAtCancel))
+	}
+	if nor.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(nor.RecvWindow), 10)
+	}
+
+	return as.orderList("POST", "sapi/v1/margin/order/oco", params)
+}
+
+func (as *apiService) QueryMarginOCO(olr OrderListRequest) (*OrderList, error) {
+	return as.orderList("GET", "sapi/v1/margin/orderList", orderListParams(olr))
+}
+
+func (as *apiService) CancelMarginOCO(olr OrderListRequest) (*OrderList, error) {
+	params := orderListParams(olr)
+	if olr.NewClientOrderID != "" {
+		params["newClientOrderId"] = olr.NewClientOrderID
+	}
+	return as.orderList("DELETE", "sapi/v1/margin/orderList", params)
+}
+
+func orderListParams(olr OrderListRequest) map[string]string {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(olr.Timestamp), 10)
+	if olr.Symbol != "" {
+		params["symbol"] = olr.Symbol
+	}
+	if olr.IsIsolated {
+		params["isIsolated"] = "TRUE"
+	}
+	if olr.OrderListID != 0 {
+		params["orderListId"] = strconv.FormatInt(olr.OrderListID, 10)
+	}
+	if olr.ListClientOrderID != "" {
+		params["listClientOrderId"] = olr.ListClientOrderID
+	}
+	if olr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(olr.RecvWindow), 10)
+	}
+	return params
+}
+
+func (as *apiService) orderList(method, endpoint string, params map[string]string) (*OrderList, error) {
+	textRes, err := as.signedRequest(method, endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return orderListFromRaw(textRes)
+}
+
+func orderListFromRaw(textRes []byte) (*OrderList, error) {
+	rawList := struct {
+		OrderListID       int64   `json:"orderListId"`
+		ContingencyType   string  `json:"contingencyType"`
+		ListStatusType    string  `json:"listStatusType"`
+		ListOrderStatus   string  `json:"listOrderStatus"`
+		ListClientOrderID string  `json:"listClientOrderId"`
+		TransactionTime   float64 `json:"transactionTime"`
+		Symbol            string  `json:"symbol"`
+		IsIsolated        bool    `json:"isIsolated"`
+		Orders            []struct {
+			Symbol        string `json:"symbol"`
+			OrderID       int64  `json:"orderId"`
+			ClientOrderID string `json:"clientOrderId"`
+		} `json:"orders"`
+		OrderReports []struct {
+			Symbol             string      `json:"symbol"`
+			OrderID            int64       `json:"orderId"`
+			ClientOrderID      string      `json:"clientOrderId"`
+			TransactTime       float64     `json:"transactTime"`
+			Price              json.Number `json:"price"`
+			OrigQty            json.Number `json:"origQty"`
+			ExecutedQty        json.Number `json:"executedQty"`
+			CumulativeQuoteQty json.Number `json:"cummulativeQuoteQty"`
+			Status             OrderStatus `json:"status"`
+			TimeInForce        TimeInForce `json:"timeInForce"`
+			Type               OrderType   `json:"type"`
+			Side               OrderSide   `json:"side"`
+		} `json:"orderReports"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawList); err != nil {
+		return nil, errors.Wrap(err, "rawList unmarshal failed")
+	}
+
+	t, err := timeFromUnixTimestampFloat(rawList.TransactionTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse OrderList.TransactionTime")
+	}
+	ol := &OrderList{
+		OrderListID:       rawList.OrderListID,
+		ContingencyType:   rawList.ContingencyType,
+		ListStatusType:    rawList.ListStatusType,
+		ListOrderStatus:   rawList.ListOrderStatus,
+		ListClientOrderID: rawList.ListClientOrderID,
+		TransactionTime:   t,
+		Symbol:            rawList.Symbol,
+		IsIsolated:        rawList.IsIsolated,
+	}
+	for _, o := range rawList.Orders {
+		ol.Orders = append(ol.Orders, &OrderListOrder{
+			Symbol:        o.Symbol,
+			OrderID:       o.OrderID,
+			ClientOrderID: o.ClientOrderID,
+		})
+	}
+	for _, r := range rawList.OrderReports {
+		tt, err := timeFromUnixTimestampFloat(r.TransactTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse ProcessedOrder.TransactTime")
+		}
+		price, _ := r.Price.Float64()
+		origQty, _ := r.OrigQty.Float64()
+		executedQty, _ := r.ExecutedQty.Float64()
+		cumulativeQuoteQty, _ := r.CumulativeQuoteQty.Float64()
+		ol.OrderReports = append(ol.OrderReports, &ProcessedOrder{
+			Symbol:             r.Symbol,
+			OrderID:            r.OrderID,
+			ClientOrderID:      r.ClientOrderID,
+			TransactTime:       tt,
+			Price:              price,
+			OrigQty:            origQty,
+			ExecutedQty:        executedQty,
+			CumulativeQuoteQty: cumulativeQuoteQty,
+			Status:             r.Status,
+			TimeInForce:        r.TimeInForce,
+			Type:               r.Type,
+			Side:               r.Side,
+			IsIsolated:         rawList.IsIsolated,
+		})
+	}
+	return ol, nil
+}
+
+func (as *apiService) MarginOrderCount(mocr MarginOrderCountRequest) ([]*OrderCount, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(mocr.Timestamp), 10)
+	if mocr.IsIsolated {
+		params["isIsolated"] = "TRUE"
+		params["symbol"] = mocr.Symbol
+	}
+	if mocr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(mocr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/margin/rateLimit/order", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawCounts []struct {
+		RateLimitType string `json:"rateLimitType"`
+		Interval      string `json:"interval"`
+		IntervalNum   int    `json:"intervalNum"`
+		Limit         int    `json:"limit"`
+		Count         int    `json:"count"`
+	}
+	if err := json.Unmarshal(textRes, &rawCounts); err != nil {
+		return nil, errors.Wrap(err, "rawCounts unmarshal failed")
+	}
+
+	var occ []*OrderCount
+	for _, rc := range rawCounts {
+		occ = append(occ, &OrderCount{
+			RateLimitType: rc.RateLimitType,
+			Interval:      rc.Interval,
+			IntervalNum:   rc.IntervalNum,
+			Limit:         rc.Limit,
+			Count:         rc.Count,
+		})
+	}
+	return occ, nil
+}
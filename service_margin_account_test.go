@@ -0,0 +1,162 @@
+package binance
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMarginLoanHistoryFromRaw(t *testing.T) {
+	textRes := []byte(`{"rows":[{"type":"AUTO","isolatedSymbol":"BNBUSDT","amount":"14.00000000","asset":"BNB",
+		"interest":"0.01866667","principal":"13.98133333","status":"CONFIRMED","timestamp":1563438204000,
+		"txId":2970933056}],"total":1}`)
+	mlh, err := marginLoanHistoryFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mlh.Total != 1 || len(mlh.Records) != 1 {
+		t.Fatalf("unexpected history: %#v", mlh)
+	}
+	r := mlh.Records[0]
+	if r.TxID != 2970933056 || r.Amount != 14 || r.Principal != 13.98133333 || r.Interest != 0.01866667 ||
+		r.IsolatedSymbol != "BNBUSDT" || r.Status != TransferConfirmed || r.Type != "AUTO" {
+		t.Errorf("unexpected record: %#v", r)
+	}
+	if !r.Time.Equal(time.Unix(1563438204, 0)) {
+		t.Errorf("unexpected time: %s", r.Time)
+	}
+}
+
+func TestIsolatedMarginAccountFromRaw(t *testing.T) {
+	textRes := []byte(`{"assets":[{"baseAsset":{"asset":"BTC","borrowEnabled":true,"borrowed":"0.5",
+		"free":"1.5","interest":"0.001","locked":"0","netAsset":"0.999","netAssetOfBtc":"0.999",
+		"repayEnabled":true,"totalAsset":"1.5"},"quoteAsset":{"asset":"USDT","borrowEnabled":true,
+		"borrowed":"0","free":"100","interest":"0","locked":"0","netAsset":"100","netAssetOfBtc":"0.01",
+		"repayEnabled":true,"totalAsset":"100"},"symbol":"BTCUSDT","isolatedCreated":true,"enabled":true,
+		"marginLevel":"3.2","marginLevelStatus":"EXCESSIVE","marginRatio":"5","indexPrice":"10000",
+		"liquidatePrice":"4500","liquidateRate":"1.1","tradeEnabled":true}],
+		"totalAssetOfBtc":"1.51","totalLiabilityOfBtc":"0.501","totalNetAssetOfBtc":"1.009"}`)
+	acc, err := isolatedMarginAccountFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acc.TotalNetAssetOfBtc != 1.009 || len(acc.Pairs) != 1 {
+		t.Fatalf("unexpected account: %#v", acc)
+	}
+	p := acc.Pairs[0]
+	if p.Symbol != "BTCUSDT" || p.MarginLevel != 3.2 || p.LiquidatePrice != 4500 || p.IndexPrice != 10000 ||
+		p.MarginLevelStatus != "EXCESSIVE" || !p.Enabled {
+		t.Errorf("unexpected pair: %#v", p)
+	}
+	if p.Base.Asset != "BTC" || p.Base.Borrowed != 0.5 || p.Base.Interest != 0.001 {
+		t.Errorf("unexpected base asset: %#v", p.Base)
+	}
+	if p.Quote.Asset != "USDT" || p.Quote.Free != 100 {
+		t.Errorf("unexpected quote asset: %#v", p.Quote)
+	}
+}
+
+func TestInterestHistoryFromRaw(t *testing.T) {
+	textRes := []byte(`{"rows":[{"txId":1352286576452864727,"interestAccuredTime":1672160400000,"asset":"USDT",
+		"rawAsset":"USDT","principal":"45.3313","interest":"0.00024995","interestRate":"0.00013233",
+		"type":"ON_BORROW","isolatedSymbol":"BNBUSDT"}],"total":1}`)
+	ih, err := interestHistoryFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ih.Total != 1 || len(ih.Records) != 1 {
+		t.Fatalf("unexpected history: %#v", ih)
+	}
+	r := ih.Records[0]
+	if r.TxID != 1352286576452864727 || r.Principal != 45.3313 || r.Interest != 0.00024995 ||
+		r.InterestRate != 0.00013233 || r.Type != "ON_BORROW" || r.IsolatedSymbol != "BNBUSDT" {
+		t.Errorf("unexpected record: %#v", r)
+	}
+	if !r.Time.Equal(time.Unix(1672160400, 0)) {
+		t.Errorf("unexpected time: %s", r.Time)
+	}
+}
+
+func TestForceLiquidationsFromRaw(t *testing.T) {
+	textRes := []byte(`{"rows":[{"avgPrice":"0.00388359","executedQty":"31.39000000","orderId":180015097,
+		"price":"0.00388110","qty":"31.39000000","side":"SELL","symbol":"BNBBTC","timeInForce":"GTC",
+		"isIsolated":true,"updatedTime":1558941374745}],"total":1}`)
+	flh, err := forceLiquidationsFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if flh.Total != 1 || len(flh.Records) != 1 {
+		t.Fatalf("unexpected history: %#v", flh)
+	}
+	fl := flh.Records[0]
+	if fl.OrderID != 180015097 || fl.Side != SideSell || fl.AvgPrice != 0.00388359 || fl.ExecutedQty != 31.39 ||
+		fl.TimeInForce != GTC || !fl.IsIsolated {
+		t.Errorf("unexpected liquidation: %#v", fl)
+	}
+}
+
+func TestCollateralRatiosFromRaw(t *testing.T) {
+	textRes := []byte(`[{"collaterals":[{"minUsdValue":"0","maxUsdValue":"13000000","discountRate":"1"},
+		{"minUsdValue":"13000000","maxUsdValue":"20000000","discountRate":"0.975"}],"assetNames":["BNX"]}]`)
+	crc, err := collateralRatiosFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(crc) != 1 || len(crc[0].Collaterals) != 2 || crc[0].Assets[0] != "BNX" {
+		t.Fatalf("unexpected ratios: %#v", crc)
+	}
+	if ct := crc[0].Collaterals[1]; ct.MinUSDValue != 13000000 || ct.MaxUSDValue != 20000000 || ct.DiscountRate != 0.975 {
+		t.Errorf("unexpected tier: %#v", ct)
+	}
+}
+
+func TestCanceledOrdersFromRaw(t *testing.T) {
+	textRes := []byte(`[{"symbol":"BTCUSDT","isIsolated":true,"origClientOrderId":"E6APeyTJvkMvLMYMqu1KQ4",
+		"orderId":11,"orderListId":-1,"clientOrderId":"pXLV6Hz6mprAcVYpVMTGgx","price":"0.089853",
+		"origQty":"0.178622","executedQty":"0.000000","cummulativeQuoteQty":"0.000000","status":"CANCELED",
+		"timeInForce":"GTC","type":"LIMIT","side":"BUY"},
+		{"orderListId":1929,"contingencyType":"OCO","listStatusType":"ALL_DONE","listOrderStatus":"ALL_DONE",
+		"listClientOrderId":"2inzWQdDvZLHbbAmAozX2N","transactionTime":1585230948299,"symbol":"BTCUSDT",
+		"isIsolated":true,"orders":[{"symbol":"BTCUSDT","orderId":20,"clientOrderId":"CwOOIPHSmYywx6jZX77TdL"},
+		{"symbol":"BTCUSDT","orderId":21,"clientOrderId":"461cPg51vQjV3zIMOXNz39"}],
+		"orderReports":[{"symbol":"BTCUSDT","origClientOrderId":"CwOOIPHSmYywx6jZX77TdL","orderId":20,
+		"orderListId":1929,"clientOrderId":"pXLV6Hz6mprAcVYpVMTGgx","status":"CANCELED","type":"STOP_LOSS_LIMIT"},
+		{"symbol":"BTCUSDT","origClientOrderId":"461cPg51vQjV3zIMOXNz39","orderId":21,"orderListId":1929,
+		"clientOrderId":"pXLV6Hz6mprAcVYpVMTGgx","status":"CANCELED","type":"LIMIT_MAKER"}]}]`)
+	coc, err := canceledOrdersFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(coc) != 3 {
+		t.Fatalf("unexpected number of canceled orders: %d", len(coc))
+	}
+	if coc[0].OrderID != 11 || coc[1].OrderID != 20 || coc[2].OrigClientOrderID != "461cPg51vQjV3zIMOXNz39" {
+		t.Errorf("unexpected canceled orders: %#v %#v %#v", coc[0], coc[1], coc[2])
+	}
+}
+
+func TestOrderListFromRaw(t *testing.T) {
+	textRes := []byte(`{"orderListId":0,"contingencyType":"OCO","listStatusType":"EXEC_STARTED",
+		"listOrderStatus":"EXECUTING","listClientOrderId":"JYVpp3F0f5CAG15DhtrqLp","transactionTime":1563417480525,
+		"symbol":"LTCBTC","isIsolated":false,"orders":[{"symbol":"LTCBTC","orderId":2,"clientOrderId":"Kk7sqHb9J6mJWTMDVW7Vos"},
+		{"symbol":"LTCBTC","orderId":3,"clientOrderId":"xTXKaGYd4bluPVp78IVRvl"}],
+		"orderReports":[{"symbol":"LTCBTC","orderId":2,"orderListId":0,"clientOrderId":"Kk7sqHb9J6mJWTMDVW7Vos",
+		"transactTime":1563417480525,"price":"0.000000","origQty":"0.624363","executedQty":"0.000000",
+		"cummulativeQuoteQty":"0.000000","status":"NEW","timeInForce":"GTC","type":"STOP_LOSS","side":"BUY",
+		"stopPrice":"0.960664"},{"symbol":"LTCBTC","orderId":3,"orderListId":0,"clientOrderId":"xTXKaGYd4bluPVp78IVRvl",
+		"transactTime":1563417480525,"price":"0.036435","origQty":"0.624363","executedQty":"0.000000",
+		"cummulativeQuoteQty":"0.000000","status":"NEW","timeInForce":"GTC","type":"LIMIT_MAKER","side":"BUY"}]}`)
+	ol, err := orderListFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ol.ContingencyType != "OCO" || ol.ListClientOrderID != "JYVpp3F0f5CAG15DhtrqLp" || len(ol.Orders) != 2 {
+		t.Fatalf("unexpected order list: %#v", ol)
+	}
+	if len(ol.OrderReports) != 2 {
+		t.Fatalf("unexpected number of order reports: %d", len(ol.OrderReports))
+	}
+	r := ol.OrderReports[1]
+	if r.OrderID != 3 || r.Type != TypeLimitMaker || r.Price != 0.036435 || r.Status != StatusNew {
+		t.Errorf("unexpected order report: %#v", r)
+	}
+}
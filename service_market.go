@@ -2,7 +2,6 @@ package binance
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"strconv"
 	"time"
@@ -16,7 +15,7 @@ func (as *apiService) Ping() error {
 	if err != nil {
 		return err
 	}
-	fmt.Printf("%#v\n", response.StatusCode)
+	response.Body.Close()
 	return nil
 }
 
@@ -61,7 +60,7 @@ func (as *apiService) OrderBook(obr OrderBookRequest) (*OrderBook, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		as.handleError(textRes)
+		as.handleError(res.StatusCode, textRes)
 	}
 
 	rawBook := &struct {
@@ -135,7 +134,7 @@ func (as *apiService) AggTrades(atr AggTradesRequest) ([]*AggTrade, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		as.handleError(textRes)
+		as.handleError(res.StatusCode, textRes)
 	}
 
 	rawAggTrades := []struct {
@@ -177,7 +176,111 @@ func (as *apiService) AggTrades(atr AggTradesRequest) ([]*AggTrade, error) {
 	return aggTrades, nil
 }
 
+func (as *apiService) RecentTrades(rtr RecentTradesRequest) ([]*MarketTrade, error) {
+	params := make(map[string]string)
+	params["symbol"] = rtr.Symbol
+	if rtr.Limit != 0 {
+		params["limit"] = strconv.Itoa(rtr.Limit)
+	}
+
+	res, err := as.request("GET", "api/v3/trades", params, false, false)
+	if err != nil {
+		return nil, err
+	}
+	textRes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read response from trades.get")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, as.handleError(res.StatusCode, textRes)
+	}
+
+	return marketTradesFromRaw(textRes)
+}
+
+func (as *apiService) HistoricalTrades(htr HistoricalTradesRequest) ([]*MarketTrade, error) {
+	params := make(map[string]string)
+	params["symbol"] = htr.Symbol
+	if htr.Limit != 0 {
+		params["limit"] = strconv.Itoa(htr.Limit)
+	}
+	if htr.FromID != nil {
+		params["fromId"] = strconv.FormatInt(*htr.FromID, 10)
+	}
+
+	res, err := as.request("GET", "api/v3/historicalTrades", params, true, false)
+	if err != nil {
+		return nil, err
+	}
+	textRes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read response from historicalTrades.get")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, as.handleError(res.StatusCode, textRes)
+	}
+
+	return marketTradesFromRaw(textRes)
+}
+
+func marketTradesFromRaw(textRes []byte) ([]*MarketTrade, error) {
+	rawTrades := []struct {
+		ID           int64   `json:"id"`
+		Price        string  `json:"price"`
+		Qty          string  `json:"qty"`
+		QuoteQty     string  `json:"quoteQty"`
+		Time         float64 `json:"time"`
+		IsBuyerMaker bool    `json:"isBuyerMaker"`
+		IsBestMatch  bool    `json:"isBestMatch"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawTrades); err != nil {
+		return nil, errors.Wrap(err, "rawTrades unmarshal failed")
+	}
+
+	mtc := []*MarketTrade{}
+	for _, rt := range rawTrades {
+		price, err := floatFromString(rt.Price)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse MarketTrade.Price")
+		}
+		qty, err := floatFromString(rt.Qty)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse MarketTrade.Qty")
+		}
+		quoteQty, err := floatFromString(rt.QuoteQty)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse MarketTrade.QuoteQty")
+		}
+		t, err := timeFromUnixTimestampFloat(rt.Time)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse MarketTrade.Time")
+		}
+		mtc = append(mtc, &MarketTrade{
+			ID:           rt.ID,
+			Price:        price,
+			Qty:          qty,
+			QuoteQty:     quoteQty,
+			Time:         t,
+			IsBuyerMaker: rt.IsBuyerMaker,
+			IsBestMatch:  rt.IsBestMatch,
+		})
+	}
+	return mtc, nil
+}
+
 func (as *apiService) Klines(kr KlinesRequest) ([]*Kline, error) {
+	return as.klines("api/v3/klines", kr)
+}
+
+func (as *apiService) UIKlines(kr KlinesRequest) ([]*Kline, error) {
+	return as.klines("api/v3/uiKlines", kr)
+}
+
+func (as *apiService) klines(endpoint string, kr KlinesRequest) ([]*Kline, error) {
 	params := make(map[string]string)
 	params["symbol"] = kr.Symbol
 	params["interval"] = string(kr.Interval)
@@ -190,8 +293,11 @@ func (as *apiService) Klines(kr KlinesRequest) ([]*Kline, error) {
 	if kr.EndTime != 0 {
 		params["endTime"] = strconv.FormatInt(kr.EndTime, 10)
 	}
+	if kr.TimeZone != "" {
+		params["timeZone"] = kr.TimeZone
+	}
 
-	res, err := as.request("GET", "api/v1/klines", params, false, false)
+	res, err := as.request("GET", endpoint, params, false, false)
 	if err != nil {
 		return nil, err
 	}
@@ -202,7 +308,7 @@ func (as *apiService) Klines(kr KlinesRequest) ([]*Kline, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawKlines := [][]interface{}{}
@@ -287,7 +393,7 @@ func (as *apiService) Ticker24(tr TickerRequest) (*Ticker24, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		as.handleError(textRes)
+		as.handleError(res.StatusCode, textRes)
 	}
 
 	rawTicker24 := struct {
@@ -399,7 +505,7 @@ func (as *apiService) TickerAllPrices() ([]*PriceTicker, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		as.handleError(textRes)
+		as.handleError(res.StatusCode, textRes)
 	}
 
 	rawTickerAllPrices := []struct {
@@ -438,7 +544,7 @@ func (as *apiService) TickerAllBooks() ([]*BookTicker, error) {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	rawBookTickers := []struct {
@@ -480,3 +586,320 @@ func (as *apiService) TickerAllBooks() ([]*BookTicker, error) {
 	}
 	return btc, nil
 }
+
+func (as *apiService) AvgPrice(apr AvgPriceRequest) (*AvgPrice, error) {
+	params := make(map[string]string)
+	params["symbol"] = apr.Symbol
+
+	res, err := as.request("GET", "api/v3/avgPrice", params, false, false)
+	if err != nil {
+		return nil, err
+	}
+	textRes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read response from avgPrice.get")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, as.handleError(res.StatusCode, textRes)
+	}
+
+	rawAvgPrice := struct {
+		Mins      int     `json:"mins"`
+		Price     string  `json:"price"`
+		CloseTime float64 `json:"closeTime"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawAvgPrice); err != nil {
+		return nil, errors.Wrap(err, "rawAvgPrice unmarshal failed")
+	}
+
+	price, err := floatFromString(rawAvgPrice.Price)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse AvgPrice.Price")
+	}
+	ct, err := timeFromUnixTimestampFloat(rawAvgPrice.CloseTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse AvgPrice.CloseTime")
+	}
+	return &AvgPrice{
+		Mins:      rawAvgPrice.Mins,
+		Price:     price,
+		CloseTime: ct,
+	}, nil
+}
+
+func (as *apiService) TickerPrice(tr TickersRequest) ([]*PriceTicker, error) {
+	params := make(map[string]string)
+	if len(tr.Symbols) > 0 {
+		params["symbols"] = symbolsParam(tr.Symbols)
+	}
+
+	res, err := as.request("GET", "api/v3/ticker/price", params, false, false)
+	if err != nil {
+		return nil, err
+	}
+	textRes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read response from ticker/price")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, as.handleError(res.StatusCode, textRes)
+	}
+
+	rawPrices := []struct {
+		Symbol string `json:"symbol"`
+		Price  string `json:"price"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawPrices); err != nil {
+		return nil, errors.Wrap(err, "rawPrices unmarshal failed")
+	}
+
+	var tpc []*PriceTicker
+	for _, rawPrice := range rawPrices {
+		p, err := floatFromString(rawPrice.Price)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse TickerPrice.Price")
+		}
+		tpc = append(tpc, &PriceTicker{
+			Symbol: rawPrice.Symbol,
+			Price:  p,
+		})
+	}
+	return tpc, nil
+}
+
+func (as *apiService) BookTicker(tr TickersRequest) ([]*BookTicker, error) {
+	params := make(map[string]string)
+	if len(tr.Symbols) > 0 {
+		params["symbols"] = symbolsParam(tr.Symbols)
+	}
+
+	res, err := as.request("GET", "api/v3/ticker/bookTicker", params, false, false)
+	if err != nil {
+		return nil, err
+	}
+	textRes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read response from ticker/bookTicker")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, as.handleError(res.StatusCode, textRes)
+	}
+
+	rawBookTickers := []struct {
+		Symbol   string `json:"symbol"`
+		BidPrice string `json:"bidPrice"`
+		BidQty   string `json:"bidQty"`
+		AskPrice string `json:"askPrice"`
+		AskQty   string `json:"askQty"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawBookTickers); err != nil {
+		return nil, errors.Wrap(err, "rawBookTickers unmarshal failed")
+	}
+
+	var btc []*BookTicker
+	for _, rawBookTicker := range rawBookTickers {
+		bp, err := floatFromString(rawBookTicker.BidPrice)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse BookTicker.BidPrice")
+		}
+		bqty, err := floatFromString(rawBookTicker.BidQty)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse BookTicker.BidQty")
+		}
+		ap, err := floatFromString(rawBookTicker.AskPrice)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse BookTicker.AskPrice")
+		}
+		aqty, err := floatFromString(rawBookTicker.AskQty)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse BookTicker.AskQty")
+		}
+		btc = append(btc, &BookTicker{
+			Symbol:   rawBookTicker.Symbol,
+			BidPrice: bp,
+			BidQty:   bqty,
+			AskPrice: ap,
+			AskQty:   aqty,
+		})
+	}
+	return btc, nil
+}
+
+func (as *apiService) Tickers24(tr TickersRequest) ([]*Ticker24, error) {
+	params := make(map[string]string)
+	if len(tr.Symbols) > 0 {
+		params["symbols"] = symbolsParam(tr.Symbols)
+	}
+	if tr.Type != "" {
+		params["type"] = string(tr.Type)
+	}
+
+	res, err := as.request("GET", "api/v3/ticker/24hr", params, false, false)
+	if err != nil {
+		return nil, err
+	}
+	textRes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read response from ticker/24hr")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, as.handleError(res.StatusCode, textRes)
+	}
+
+	return tickers24FromRaw(textRes)
+}
+
+func (as *apiService) RollingTickers(rtr RollingTickersRequest) ([]*Ticker24, error) {
+	if len(rtr.Symbols) == 0 {
+		return nil, errors.New("at least one symbol required")
+	}
+	params := make(map[string]string)
+	params["symbols"] = symbolsParam(rtr.Symbols)
+	if rtr.WindowSize != 0 {
+		ws, err := windowSizeParam(rtr.WindowSize)
+		if err != nil {
+			return nil, err
+		}
+		params["windowSize"] = ws
+	}
+	if rtr.Type != "" {
+		params["type"] = string(rtr.Type)
+	}
+
+	res, err := as.request("GET", "api/v3/ticker", params, false, false)
+	if err != nil {
+		return nil, err
+	}
+	textRes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read response from ticker")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, as.handleError(res.StatusCode, textRes)
+	}
+
+	return tickers24FromRaw(textRes)
+}
+
+type rawTicker24 struct {
+	Symbol             string      `json:"symbol"`
+	PriceChange        json.Number `json:"priceChange"`
+	PriceChangePercent json.Number `json:"priceChangePercent"`
+	WeightedAvgPrice   json.Number `json:"weightedAvgPrice"`
+	PrevClosePrice     json.Number `json:"prevClosePrice"`
+	LastPrice          json.Number `json:"lastPrice"`
+	LastQty            json.Number `json:"lastQty"`
+	BidPrice           json.Number `json:"bidPrice"`
+	BidQty             json.Number `json:"bidQty"`
+	AskPrice           json.Number `json:"askPrice"`
+	AskQty             json.Number `json:"askQty"`
+	OpenPrice          json.Number `json:"openPrice"`
+	HighPrice          json.Number `json:"highPrice"`
+	LowPrice           json.Number `json:"lowPrice"`
+	Volume             json.Number `json:"volume"`
+	QuoteVolume        json.Number `json:"quoteVolume"`
+	OpenTime           float64     `json:"openTime"`
+	CloseTime          float64     `json:"closeTime"`
+	FirstID            int         `json:"firstId"`
+	LastID             int         `json:"lastId"`
+	Count              int         `json:"count"`
+}
+
+func tickers24FromRaw(textRes []byte) ([]*Ticker24, error) {
+	rawTickers := []*rawTicker24{}
+	if err := json.Unmarshal(textRes, &rawTickers); err != nil {
+		return nil, errors.Wrap(err, "rawTickers unmarshal failed")
+	}
+
+	var t24c []*Ticker24
+	for _, rt := range rawTickers {
+		ot, err := timeFromUnixTimestampFloat(rt.OpenTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse Ticker24.OpenTime")
+		}
+		ct, err := timeFromUnixTimestampFloat(rt.CloseTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse Ticker24.CloseTime")
+		}
+		// fields missing in MINI and rolling window tickers are left zero
+		pc, _ := rt.PriceChange.Float64()
+		pcPercent, _ := rt.PriceChangePercent.Float64()
+		wap, _ := rt.WeightedAvgPrice.Float64()
+		pcp, _ := rt.PrevClosePrice.Float64()
+		lastPrice, _ := rt.LastPrice.Float64()
+		lastQty, _ := rt.LastQty.Float64()
+		bp, _ := rt.BidPrice.Float64()
+		bqty, _ := rt.BidQty.Float64()
+		ap, _ := rt.AskPrice.Float64()
+		aqty, _ := rt.AskQty.Float64()
+		op, _ := rt.OpenPrice.Float64()
+		hp, _ := rt.HighPrice.Float64()
+		lowPrice, _ := rt.LowPrice.Float64()
+		vol, _ := rt.Volume.Float64()
+		qvol, _ := rt.QuoteVolume.Float64()
+		t24c = append(t24c, &Ticker24{
+			Symbol:             rt.Symbol,
+			PriceChange:        pc,
+			PriceChangePercent: pcPercent,
+			WeightedAvgPrice:   wap,
+			PrevClosePrice:     pcp,
+			LastPrice:          lastPrice,
+			LastQty:            lastQty,
+			BidPrice:           bp,
+			BidQty:             bqty,
+			AskPrice:           ap,
+			AskQty:             aqty,
+			OpenPrice:          op,
+			HighPrice:          hp,
+			LowPrice:           lowPrice,
+			Volume:             vol,
+			QuoteVolume:        qvol,
+			OpenTime:           ot,
+			CloseTime:          ct,
+			FirstID:            rt.FirstID,
+			LastID:             rt.LastID,
+			Count:              rt.Count,
+		})
+	}
+	return t24c, nil
+}
+
+func (as *apiService) TradingDayTickers(tr TradingDayTickersRequest) ([]*Ticker24, error) {
+	if len(tr.Symbols) == 0 {
+		return nil, errors.New("at least one symbol required")
+	}
+	params := make(map[string]string)
+	params["symbols"] = symbolsParam(tr.Symbols)
+	if tr.TimeZone != "" {
+		params["timeZone"] = tr.TimeZone
+	}
+	if tr.Type != "" {
+		params["type"] = string(tr.Type)
+	}
+
+	res, err := as.request("GET", "api/v3/ticker/tradingDay", params, false, false)
+	if err != nil {
+		return nil, err
+	}
+	textRes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read response from ticker/tradingDay")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 200 {
+		return nil, as.handleError(res.StatusCode, textRes)
+	}
+
+	return tickers24FromRaw(textRes)
+}
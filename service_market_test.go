@@ -0,0 +1,176 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// marketServer serves body and records path and query of the last request.
+// Server is closed by returned func.
+func marketServer(body string) (Service, *url.URL, func()) {
+	last := &url.URL{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*last = *r.URL
+		w.Write([]byte(body))
+	}))
+	return NewAPIService(server.URL, "", nil, nil, context.Background()), last, server.Close
+}
+
+const rawKlines = `[[1499040000000,"0.01634790","0.80000000","0.01575800","0.01577100",
+	"148976.11427815",1499644799999,"2434.19055334",308,"1756.87402397","28.46694368","0"]]`
+
+func TestUIKlines(t *testing.T) {
+	as, last, closeServer := marketServer(rawKlines)
+	defer closeServer()
+	kc, err := as.UIKlines(KlinesRequest{
+		Symbol:   "BNBBTC",
+		Interval: Hour,
+		Limit:    10,
+		TimeZone: "+08:00",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last.Path != "/api/v3/uiKlines" {
+		t.Errorf("unexpected path: %s", last.Path)
+	}
+	q := last.Query()
+	if q.Get("symbol") != "BNBBTC" || q.Get("interval") != "1h" || q.Get("limit") != "10" ||
+		q.Get("timeZone") != "+08:00" {
+		t.Errorf("unexpected params: %s", last.RawQuery)
+	}
+	if len(kc) != 1 {
+		t.Fatalf("unexpected number of klines: %d", len(kc))
+	}
+	k := kc[0]
+	if !k.OpenTime.Equal(time.Unix(1499040000, 0)) || k.Open != 0.0163479 || k.Close != 0.015771 ||
+		k.NumberOfTrades != 308 || k.TakerBuyQuoteAssetVolume != 28.46694368 {
+		t.Errorf("unexpected kline: %#v", k)
+	}
+}
+
+func TestKlinesTimeZone(t *testing.T) {
+	as, last, closeServer := marketServer(rawKlines)
+	defer closeServer()
+	if _, err := as.Klines(KlinesRequest{Symbol: "BNBBTC", Interval: Hour}); err != nil {
+		t.Fatal(err)
+	}
+	if last.Path != "/api/v3/klines" {
+		t.Errorf("unexpected path: %s", last.Path)
+	}
+	if _, ok := last.Query()["timeZone"]; ok {
+		t.Errorf("timeZone sent without time zone: %s", last.RawQuery)
+	}
+	if _, err := as.Klines(KlinesRequest{Symbol: "BNBBTC", Interval: Hour, TimeZone: "-1:30"}); err != nil {
+		t.Fatal(err)
+	}
+	if tz := last.Query().Get("timeZone"); tz != "-1:30" {
+		t.Errorf("unexpected timeZone: %s", tz)
+	}
+}
+
+func TestTradingDayTickers(t *testing.T) {
+	as, last, closeServer := marketServer(`[{"symbol":"BTCUSDT","priceChange":"-83.13000000",
+		"priceChangePercent":"-0.317","weightedAvgPrice":"26234.58803036","openPrice":"26304.80000000",
+		"highPrice":"26397.46000000","lowPrice":"26088.34000000","lastPrice":"26221.67000000",
+		"volume":"18495.35066000","quoteVolume":"485217905.04210480","openTime":1695686400000,
+		"closeTime":1695772799999,"firstId":3220151555,"lastId":3220849281,"count":697727}]`)
+	defer closeServer()
+	t24c, err := as.TradingDayTickers(TradingDayTickersRequest{
+		Symbols:  []string{"BTCUSDT"},
+		TimeZone: "8",
+		Type:     TickerTypeFull,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last.Path != "/api/v3/ticker/tradingDay" {
+		t.Errorf("unexpected path: %s", last.Path)
+	}
+	q := last.Query()
+	if q.Get("symbols") != `["BTCUSDT"]` || q.Get("timeZone") != "8" || q.Get("type") != "FULL" {
+		t.Errorf("unexpected params: %s", last.RawQuery)
+	}
+	if len(t24c) != 1 {
+		t.Fatalf("unexpected number of tickers: %d", len(t24c))
+	}
+	t24 := t24c[0]
+	if t24.Symbol != "BTCUSDT" || t24.PriceChange != -83.13 || t24.LastPrice != 26221.67 ||
+		t24.WeightedAvgPrice != 26234.58803036 || t24.Count != 697727 {
+		t.Errorf("unexpected ticker: %#v", t24)
+	}
+}
+
+func TestTickers24FromRawMini(t *testing.T) {
+	textRes := []byte(`[{"symbol":"BNBBTC","openPrice":"99.00000000","highPrice":"100.00000000",
+		"lowPrice":"0.10000000","lastPrice":"4.00000200","volume":"8913.30000000",
+		"quoteVolume":"15.30000000","openTime":1499783499040,"closeTime":1499869899040,
+		"firstId":28385,"lastId":28460,"count":76}]`)
+	t24c, err := tickers24FromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(t24c) != 1 {
+		t.Fatalf("unexpected number of tickers: %d", len(t24c))
+	}
+	t24 := t24c[0]
+	if t24.Symbol != "BNBBTC" || t24.LastPrice != 4.000002 || t24.QuoteVolume != 15.3 || t24.Count != 76 {
+		t.Errorf("unexpected ticker: %#v", t24)
+	}
+	if t24.BidPrice != 0 || t24.PriceChange != 0 {
+		t.Errorf("fields missing in MINI ticker should be zero: %#v", t24)
+	}
+}
+
+func TestWindowSizeParam(t *testing.T) {
+	for d, expected := range map[time.Duration]string{
+		time.Minute:        "1m",
+		30 * time.Minute:   "30m",
+		59 * time.Minute:   "59m",
+		time.Hour:          "1h",
+		4 * time.Hour:      "4h",
+		23 * time.Hour:     "23h",
+		24 * time.Hour:     "1d",
+		7 * 24 * time.Hour: "7d",
+	} {
+		ws, err := windowSizeParam(d)
+		if err != nil || ws != expected {
+			t.Errorf("unexpected window size for %s: %s, %v", d, ws, err)
+		}
+	}
+	for _, d := range []time.Duration{
+		0,
+		30 * time.Second,
+		90 * time.Minute,
+		36 * time.Hour,
+		8 * 24 * time.Hour,
+		-time.Hour,
+	} {
+		if ws, err := windowSizeParam(d); err == nil {
+			t.Errorf("invalid window size %s accepted: %s", d, ws)
+		}
+	}
+}
+
+func TestTickersRequireSymbols(t *testing.T) {
+	as := &apiService{}
+	if _, err := as.RollingTickers(RollingTickersRequest{WindowSize: time.Hour}); err == nil {
+		t.Error("RollingTickers without symbols not rejected")
+	}
+	if _, err := as.RollingTickers(RollingTickersRequest{Symbols: []string{"BNBBTC"}, WindowSize: 90 * time.Minute}); err == nil {
+		t.Error("RollingTickers with invalid window size not rejected")
+	}
+	if _, err := as.TradingDayTickers(TradingDayTickersRequest{}); err == nil {
+		t.Error("TradingDayTickers without symbols not rejected")
+	}
+}
+
+func TestSymbolsParam(t *testing.T) {
+	if s := symbolsParam([]string{"BTCUSDT", "BNBBTC"}); s != `["BTCUSDT","BNBBTC"]` {
+		t.Errorf("unexpected symbols param: %s", s)
+	}
+}
@@ -0,0 +1,321 @@
+package binance
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+func (as *apiService) SubAccounts(sar SubAccountsRequest) ([]*SubAccount, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(sar.Timestamp), 10)
+	if sar.Email != "" {
+		params["email"] = sar.Email
+	}
+	if sar.IsFreeze != nil {
+		params["isFreeze"] = strconv.FormatBool(*sar.IsFreeze)
+	}
+	if sar.Page != 0 {
+		params["page"] = strconv.Itoa(sar.Page)
+	}
+	if sar.Limit != 0 {
+		params["limit"] = strconv.Itoa(sar.Limit)
+	}
+	if sar.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(sar.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/sub-account/list", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return subAccountsFromRaw(textRes)
+}
+
+func subAccountsFromRaw(textRes []byte) ([]*SubAccount, error) {
+	rawList := struct {
+		SubAccounts []struct {
+			Email                       string  `json:"email"`
+			IsFreeze                    bool    `json:"isFreeze"`
+			CreateTime                  float64 `json:"createTime"`
+			IsManagedSubAccount         bool    `json:"isManagedSubAccount"`
+			IsAssetManagementSubAccount bool    `json:"isAssetManagementSubAccount"`
+		} `json:"subAccounts"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawList); err != nil {
+		return nil, errors.Wrap(err, "rawList unmarshal failed")
+	}
+
+	var sac []*SubAccount
+	for _, rsa := range rawList.SubAccounts {
+		t, err := timeFromUnixTimestampFloat(rsa.CreateTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse SubAccount.CreateTime")
+		}
+		sac = append(sac, &SubAccount{
+			Email:                       rsa.Email,
+			IsFreeze:                    rsa.IsFreeze,
+			CreateTime:                  t,
+			IsManagedSubAccount:         rsa.IsManagedSubAccount,
+			IsAssetManagementSubAccount: rsa.IsAssetManagementSubAccount,
+		})
+	}
+	return sac, nil
+}
+
+func (as *apiService) CreateVirtualSubAccount(cvr CreateVirtualSubAccountRequest) (string, error) {
+	params := make(map[string]string)
+	params["subAccountString"] = cvr.SubAccountString
+	params["timestamp"] = strconv.FormatInt(unixMillis(cvr.Timestamp), 10)
+	if cvr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(cvr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/sub-account/virtualSubAccount", params)
+	if err != nil {
+		return "", err
+	}
+
+	rawResult := struct {
+		Email string `json:"email"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawResult); err != nil {
+		return "", errors.Wrap(err, "rawResult unmarshal failed")
+	}
+	return rawResult.Email, nil
+}
+
+func (as *apiService) SubAccountAssets(sar SubAccountRequest) ([]*Balance, error) {
+	textRes, err := as.signedRequest("GET", "sapi/v3/sub-account/assets", subAccountParams(sar))
+	if err != nil {
+		return nil, err
+	}
+	return subAccountAssetsFromRaw(textRes)
+}
+
+func subAccountAssetsFromRaw(textRes []byte) ([]*Balance, error) {
+	rawAssets := struct {
+		Balances []struct {
+			Asset  string      `json:"asset"`
+			Free   json.Number `json:"free"`
+			Locked json.Number `json:"locked"`
+		} `json:"balances"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawAssets); err != nil {
+		return nil, errors.Wrap(err, "rawAssets unmarshal failed")
+	}
+
+	var bc []*Balance
+	for _, rb := range rawAssets.Balances {
+		free, _ := rb.Free.Float64()
+		locked, _ := rb.Locked.Float64()
+		bc = append(bc, &Balance{
+			Asset:  rb.Asset,
+			Free:   free,
+			Locked: locked,
+		})
+	}
+	return bc, nil
+}
+
+func (as *apiService) SubAccountMarginAccount(sar SubAccountRequest) (*SubAccountMarginAccount, error) {
+	textRes, err := as.signedRequest("GET", "sapi/v1/sub-account/margin/account", subAccountParams(sar))
+	if err != nil {
+		return nil, err
+	}
+	return subAccountMarginAccountFromRaw(textRes)
+}
+
+func subAccountMarginAccountFromRaw(textRes []byte) (*SubAccountMarginAccount, error) {
+	rawAccount := struct {
+		Email               string      `json:"email"`
+		MarginLevel         json.Number `json:"marginLevel"`
+		TotalAssetOfBtc     json.Number `json:"totalAssetOfBtc"`
+		TotalLiabilityOfBtc json.Number `json:"totalLiabilityOfBtc"`
+		TotalNetAssetOfBtc  json.Number `json:"totalNetAssetOfBtc"`
+		MarginTradeCoeffVo  struct {
+			ForceLiquidationBar json.Number `json:"forceLiquidationBar"`
+			MarginCallBar       json.Number `json:"marginCallBar"`
+			NormalBar           json.Number `json:"normalBar"`
+		} `json:"marginTradeCoeffVo"`
+		MarginUserAssetVoList []struct {
+			Asset    string      `json:"asset"`
+			Borrowed json.Number `json:"borrowed"`
+			Free     json.Number `json:"free"`
+			Interest json.Number `json:"interest"`
+			Locked   json.Number `json:"locked"`
+			NetAsset json.Number `json:"netAsset"`
+		} `json:"marginUserAssetVoList"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawAccount); err != nil {
+		return nil, errors.Wrap(err, "rawAccount unmarshal failed")
+	}
+
+	marginLevel, _ := rawAccount.MarginLevel.Float64()
+	totalAssetOfBtc, _ := rawAccount.TotalAssetOfBtc.Float64()
+	totalLiabilityOfBtc, _ := rawAccount.TotalLiabilityOfBtc.Float64()
+	totalNetAssetOfBtc, _ := rawAccount.TotalNetAssetOfBtc.Float64()
+	forceLiquidationBar, _ := rawAccount.MarginTradeCoeffVo.ForceLiquidationBar.Float64()
+	marginCallBar, _ := rawAccount.MarginTradeCoeffVo.MarginCallBar.Float64()
+	normalBar, _ := rawAccount.MarginTradeCoeffVo.NormalBar.Float64()
+	acc := &SubAccountMarginAccount{
+		Email:               rawAccount.Email,
+		MarginLevel:         marginLevel,
+		TotalAssetOfBtc:     totalAssetOfBtc,
+		TotalLiabilityOfBtc: totalLiabilityOfBtc,
+		TotalNetAssetOfBtc:  totalNetAssetOfBtc,
+		ForceLiquidationBar: forceLiquidationBar,
+		MarginCallBar:       marginCallBar,
+		NormalBar:           normalBar,
+	}
+	for _, b := range rawAccount.MarginUserAssetVoList {
+		borrowed, _ := b.Borrowed.Float64()
+		free, _ := b.Free.Float64()
+		interest, _ := b.Interest.Float64()
+		locked, _ := b.Locked.Float64()
+		netAsset, _ := b.NetAsset.Float64()
+		acc.Assets = append(acc.Assets, &Asset{
+			Asset:    b.Asset,
+			Borrowed: borrowed,
+			Free:     free,
+			Interest: interest,
+			Locked:   locked,
+			NetAsset: netAsset,
+		})
+	}
+	return acc, nil
+}
+
+func (as *apiService) SubAccountTransfer(str SubAccountTransferRequest) (*SubAccountTransferResult, error) {
+	params := make(map[string]string)
+	params["fromAccountType"] = string(str.FromAccountType)
+	params["toAccountType"] = string(str.ToAccountType)
+	params["asset"] = str.Asset
+	params["amount"] = strconv.FormatFloat(str.Amount, 'f', -1, 64)
+	params["timestamp"] = strconv.FormatInt(unixMillis(str.Timestamp), 10)
+	if str.FromEmail != "" {
+		params["fromEmail"] = str.FromEmail
+	}
+	if str.ToEmail != "" {
+		params["toEmail"] = str.ToEmail
+	}
+	if str.ClientTranID != "" {
+		params["clientTranId"] = str.ClientTranID
+	}
+	if str.Symbol != "" {
+		params["symbol"] = str.Symbol
+	}
+	if str.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(str.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/sub-account/universalTransfer", params)
+	if err != nil {
+		return nil, err
+	}
+
+	rawResult := struct {
+		TranID       int64  `json:"tranId"`
+		ClientTranID string `json:"clientTranId"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawResult); err != nil {
+		return nil, errors.Wrap(err, "rawResult unmarshal failed")
+	}
+	return &SubAccountTransferResult{
+		TranID:       rawResult.TranID,
+		ClientTranID: rawResult.ClientTranID,
+	}, nil
+}
+
+func (as *apiService) SubAccountTransferHistory(sthr SubAccountTransferHistoryRequest) (*SubAccountTransferHistory, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(sthr.Timestamp), 10)
+	if sthr.FromEmail != "" {
+		params["fromEmail"] = sthr.FromEmail
+	}
+	if sthr.ToEmail != "" {
+		params["toEmail"] = sthr.ToEmail
+	}
+	if sthr.ClientTranID != "" {
+		params["clientTranId"] = sthr.ClientTranID
+	}
+	if !sthr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(sthr.StartTime), 10)
+	}
+	if !sthr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(sthr.EndTime), 10)
+	}
+	if sthr.Page != 0 {
+		params["page"] = strconv.Itoa(sthr.Page)
+	}
+	if sthr.Limit != 0 {
+		params["limit"] = strconv.Itoa(sthr.Limit)
+	}
+	if sthr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(sthr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/sub-account/universalTransfer", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return subAccountTransferHistoryFromRaw(textRes)
+}
+
+func subAccountTransferHistoryFromRaw(textRes []byte) (*SubAccountTransferHistory, error) {
+	rawHistory := struct {
+		TotalCount int `json:"totalCount"`
+		Result     []struct {
+			TranID          int64                    `json:"tranId"`
+			ClientTranID    string                   `json:"clientTranId"`
+			FromEmail       string                   `json:"fromEmail"`
+			ToEmail         string                   `json:"toEmail"`
+			FromAccountType SubAccountType           `json:"fromAccountType"`
+			ToAccountType   SubAccountType           `json:"toAccountType"`
+			Asset           string                   `json:"asset"`
+			Amount          json.Number              `json:"amount"`
+			Status          SubAccountTransferStatus `json:"status"`
+			CreateTimeStamp float64                  `json:"createTimeStamp"`
+		} `json:"result"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawHistory); err != nil {
+		return nil, errors.Wrap(err, "rawHistory unmarshal failed")
+	}
+
+	sth := &SubAccountTransferHistory{
+		Total: rawHistory.TotalCount,
+	}
+	for _, r := range rawHistory.Result {
+		t, err := timeFromUnixTimestampFloat(r.CreateTimeStamp)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse SubAccountTransferRecord.Time")
+		}
+		amount, _ := r.Amount.Float64()
+		sth.Transfers = append(sth.Transfers, &SubAccountTransferRecord{
+			TranID:          r.TranID,
+			ClientTranID:    r.ClientTranID,
+			FromEmail:       r.FromEmail,
+			ToEmail:         r.ToEmail,
+			FromAccountType: r.FromAccountType,
+			ToAccountType:   r.ToAccountType,
+			Asset:           r.Asset,
+			Amount:          amount,
+			Status:          r.Status,
+			Time:            t,
+		})
+	}
+	return sth, nil
+}
+
+func subAccountParams(sar SubAccountRequest) map[string]string {
+	params := make(map[string]string)
+	params["email"] = sar.Email
+	params["timestamp"] = strconv.FormatInt(unixMillis(sar.Timestamp), 10)
+	if sar.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(sar.RecvWindow), 10)
+	}
+	return params
+}
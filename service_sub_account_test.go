@@ -0,0 +1,70 @@
+package binance
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSubAccountsFromRaw(t *testing.T) {
+	textRes := []byte(`{"subAccounts":[{"email":"testsub@gmail.com","isFreeze":false,"createTime":1544433328000,
+		"isManagedSubAccount":false,"isAssetManagementSubAccount":false},
+		{"email":"virtual@test.com","isFreeze":true,"createTime":1544433328000,
+		"isManagedSubAccount":true,"isAssetManagementSubAccount":false}]}`)
+	sac, err := subAccountsFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sac) != 2 || sac[0].Email != "testsub@gmail.com" || !sac[0].CreateTime.Equal(time.Unix(1544433328, 0)) {
+		t.Fatalf("unexpected sub-accounts: %#v", sac)
+	}
+	if !sac[1].IsFreeze || !sac[1].IsManagedSubAccount {
+		t.Errorf("unexpected sub-account: %#v", sac[1])
+	}
+}
+
+func TestSubAccountAssetsFromRaw(t *testing.T) {
+	textRes := []byte(`{"balances":[{"asset":"ADA","free":10000,"locked":0},{"asset":"BNB","free":"10003.5","locked":"1"}]}`)
+	bc, err := subAccountAssetsFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bc) != 2 || bc[0].Free != 10000 || bc[1].Free != 10003.5 || bc[1].Locked != 1 {
+		t.Errorf("unexpected balances: %#v", bc)
+	}
+}
+
+func TestSubAccountMarginAccountFromRaw(t *testing.T) {
+	textRes := []byte(`{"email":"123@test.com","marginLevel":"11.64405625","totalAssetOfBtc":"6.82728457",
+		"totalLiabilityOfBtc":"0.58633215","totalNetAssetOfBtc":"6.24095242",
+		"marginTradeCoeffVo":{"forceLiquidationBar":"1.10000000","marginCallBar":"1.50000000","normalBar":"2.00000000"},
+		"marginUserAssetVoList":[{"asset":"BTC","borrowed":"0.00000000","free":"0.00499500","interest":"0.00000000",
+		"locked":"0.00000000","netAsset":"0.00499500"}]}`)
+	acc, err := subAccountMarginAccountFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acc.Email != "123@test.com" || acc.MarginLevel != 11.64405625 || acc.ForceLiquidationBar != 1.1 || acc.NormalBar != 2 {
+		t.Errorf("unexpected account: %#v", acc)
+	}
+	if len(acc.Assets) != 1 || acc.Assets[0].Free != 0.004995 {
+		t.Errorf("unexpected assets: %#v", acc.Assets)
+	}
+}
+
+func TestSubAccountTransferHistoryFromRaw(t *testing.T) {
+	textRes := []byte(`{"result":[{"tranId":92275823339,"fromEmail":"abctest@gmail.com","toEmail":"deftest@gmail.com",
+		"asset":"BNB","amount":"0.01","createTimeStamp":1640317374000,"fromAccountType":"USDT_FUTURE",
+		"toAccountType":"SPOT","status":"SUCCESS","clientTranId":"test"}],"totalCount":1}`)
+	sth, err := subAccountTransferHistoryFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sth.Total != 1 || len(sth.Transfers) != 1 {
+		t.Fatalf("unexpected history: %#v", sth)
+	}
+	tr := sth.Transfers[0]
+	if tr.TranID != 92275823339 || tr.Amount != 0.01 || tr.FromAccountType != SubAccountUSDTFuture ||
+		tr.ToAccountType != SubAccountSpot || tr.Status != SubAccountTransferSuccess || tr.ClientTranID != "test" {
+		t.Errorf("unexpected transfer: %#v", tr)
+	}
+}
@@ -0,0 +1,236 @@
+package binance
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+func (as *apiService) UniversalTransfer(utr UniversalTransferRequest) (*TransferResult, error) {
+	params := make(map[string]string)
+	params["type"] = string(utr.Type)
+	params["asset"] = utr.Asset
+	params["amount"] = strconv.FormatFloat(utr.Amount, 'f', -1, 64)
+	params["timestamp"] = strconv.FormatInt(unixMillis(utr.Timestamp), 10)
+	if utr.FromSymbol != "" {
+		params["fromSymbol"] = utr.FromSymbol
+	}
+	if utr.ToSymbol != "" {
+		params["toSymbol"] = utr.ToSymbol
+	}
+	if utr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(utr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/asset/transfer", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return transferResultFromRaw(textRes)
+}
+
+func (as *apiService) UniversalTransferHistory(uthr UniversalTransferHistoryRequest) (*TransferHistory, error) {
+	params := make(map[string]string)
+	params["type"] = string(uthr.Type)
+	params["timestamp"] = strconv.FormatInt(unixMillis(uthr.Timestamp), 10)
+	if !uthr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(uthr.StartTime), 10)
+	}
+	if !uthr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(uthr.EndTime), 10)
+	}
+	if uthr.Current != 0 {
+		params["current"] = strconv.Itoa(uthr.Current)
+	}
+	if uthr.Size != 0 {
+		params["size"] = strconv.Itoa(uthr.Size)
+	}
+	if uthr.FromSymbol != "" {
+		params["fromSymbol"] = uthr.FromSymbol
+	}
+	if uthr.ToSymbol != "" {
+		params["toSymbol"] = uthr.ToSymbol
+	}
+	if uthr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(uthr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/asset/transfer", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return transferHistoryFromRaw(textRes)
+}
+
+func transferHistoryFromRaw(textRes []byte) (*TransferHistory, error) {
+	rawHistory := struct {
+		Total int `json:"total"`
+		Rows  []struct {
+			TranID    int64          `json:"tranId"`
+			Asset     string         `json:"asset"`
+			Amount    string         `json:"amount"`
+			Type      TransferType   `json:"type"`
+			Status    TransferStatus `json:"status"`
+			Timestamp float64        `json:"timestamp"`
+		} `json:"rows"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawHistory); err != nil {
+		return nil, errors.Wrap(err, "rawHistory unmarshal failed")
+	}
+
+	th := &TransferHistory{
+		Total: rawHistory.Total,
+	}
+	for _, r := range rawHistory.Rows {
+		amount, err := floatFromString(r.Amount)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse Transfer.Amount")
+		}
+		t, err := timeFromUnixTimestampFloat(r.Timestamp)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse Transfer.Time")
+		}
+		th.Transfers = append(th.Transfers, &Transfer{
+			TranID: r.TranID,
+			Asset:  r.Asset,
+			Amount: amount,
+			Type:   r.Type,
+			Status: r.Status,
+			Time:   t,
+		})
+	}
+	return th, nil
+}
+
+func (as *apiService) MarginTransfer(mtr MarginTransferRequest) (*TransferResult, error) {
+	params := make(map[string]string)
+	params["asset"] = mtr.Asset
+	params["amount"] = strconv.FormatFloat(mtr.Amount, 'f', -1, 64)
+	params["type"] = strconv.Itoa(int(mtr.Type))
+	params["timestamp"] = strconv.FormatInt(unixMillis(mtr.Timestamp), 10)
+	if mtr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(mtr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/margin/transfer", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return transferResultFromRaw(textRes)
+}
+
+func (as *apiService) IsolatedMarginTransfer(imtr IsolatedMarginTransferRequest) (*TransferResult, error) {
+	params := make(map[string]string)
+	params["asset"] = imtr.Asset
+	params["symbol"] = imtr.Symbol
+	params["transFrom"] = string(imtr.From)
+	params["transTo"] = string(imtr.To)
+	params["amount"] = strconv.FormatFloat(imtr.Amount, 'f', -1, 64)
+	params["timestamp"] = strconv.FormatInt(unixMillis(imtr.Timestamp), 10)
+	if imtr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(imtr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/margin/isolated/transfer", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return transferResultFromRaw(textRes)
+}
+
+func (as *apiService) MarginTransferHistory(mthr MarginTransferHistoryRequest) (*MarginTransferHistory, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(mthr.Timestamp), 10)
+	if mthr.Asset != "" {
+		params["asset"] = mthr.Asset
+	}
+	if mthr.Direction != "" {
+		params["type"] = string(mthr.Direction)
+	}
+	if mthr.IsolatedSymbol != "" {
+		params["isolatedSymbol"] = mthr.IsolatedSymbol
+	}
+	if !mthr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(mthr.StartTime), 10)
+	}
+	if !mthr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(mthr.EndTime), 10)
+	}
+	if mthr.Current != 0 {
+		params["current"] = strconv.Itoa(mthr.Current)
+	}
+	if mthr.Size != 0 {
+		params["size"] = strconv.Itoa(mthr.Size)
+	}
+	if mthr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(mthr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/margin/transfer", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return marginTransferHistoryFromRaw(textRes)
+}
+
+func marginTransferHistoryFromRaw(textRes []byte) (*MarginTransferHistory, error) {
+	rawHistory := struct {
+		Total int `json:"total"`
+		Rows  []struct {
+			TxID      int64                   `json:"txId"`
+			Asset     string                  `json:"asset"`
+			Amount    string                  `json:"amount"`
+			Type      MarginTransferDirection `json:"type"`
+			Status    TransferStatus          `json:"status"`
+			TransFrom MarginWallet            `json:"transFrom"`
+			TransTo   MarginWallet            `json:"transTo"`
+			Timestamp float64                 `json:"timestamp"`
+		} `json:"rows"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawHistory); err != nil {
+		return nil, errors.Wrap(err, "rawHistory unmarshal failed")
+	}
+
+	mth := &MarginTransferHistory{
+		Total: rawHistory.Total,
+	}
+	for _, r := range rawHistory.Rows {
+		amount, err := floatFromString(r.Amount)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse MarginTransferRecord.Amount")
+		}
+		t, err := timeFromUnixTimestampFloat(r.Timestamp)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse MarginTransferRecord.Time")
+		}
+		mth.Transfers = append(mth.Transfers, &MarginTransferRecord{
+			TranID:    r.TxID,
+			Asset:     r.Asset,
+			Amount:    amount,
+			Direction: r.Type,
+			Status:    r.Status,
+			From:      r.TransFrom,
+			To:        r.TransTo,
+			Time:      t,
+		})
+	}
+	return mth, nil
+}
+
+func transferResultFromRaw(textRes []byte) (*TransferResult, error) {
+	rawResult := struct {
+		TranID int64 `json:"tranId"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawResult); err != nil {
+		return nil, errors.Wrap(err, "rawResult unmarshal failed")
+	}
+	return &TransferResult{
+		TranID: rawResult.TranID,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package binance
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTransferHistoryFromRaw(t *testing.T) {
+	textRes := []byte(`{"total":2,"rows":[
+		{"asset":"USDT","amount":"1","type":"MAIN_UMFUTURE","status":"CONFIRMED","tranId":11415955596,"timestamp":1544433328000},
+		{"asset":"USDT","amount":"2","type":"MAIN_UMFUTURE","status":"CONFIRMED","tranId":11366865406,"timestamp":1544433328000}]}`)
+	th, err := transferHistoryFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if th.Total != 2 || len(th.Transfers) != 2 {
+		t.Fatalf("unexpected history: %#v", th)
+	}
+	tr := th.Transfers[1]
+	if tr.TranID != 11366865406 || tr.Amount != 2 || tr.Type != TransferMainToUMFuture || tr.Status != TransferConfirmed {
+		t.Errorf("unexpected transfer: %#v", tr)
+	}
+	if !tr.Time.Equal(time.Unix(1544433328, 0)) {
+		t.Errorf("unexpected time: %s", tr.Time)
+	}
+}
+
+func TestMarginTransferHistoryFromRaw(t *testing.T) {
+	textRes := []byte(`{"rows":[{"amount":"0.10000000","asset":"BNB","status":"CONFIRMED",
+		"timestamp":1566898617000,"txId":5240372201,"type":"ROLL_IN","transFrom":"SPOT","transTo":"ISOLATED_MARGIN"}],"total":1}`)
+	mth, err := marginTransferHistoryFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mth.Total != 1 || len(mth.Transfers) != 1 {
+		t.Fatalf("unexpected history: %#v", mth)
+	}
+	mt := mth.Transfers[0]
+	if mt.TranID != 5240372201 || mt.Amount != 0.1 || mt.Direction != MarginTransferRollIn ||
+		mt.From != MarginWalletSpot || mt.To != MarginWalletIsolated {
+		t.Errorf("unexpected transfer: %#v", mt)
+	}
+}
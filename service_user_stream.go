@@ -3,7 +3,6 @@ package binance
 import (
 	"encoding/json"
 	"io/ioutil"
-	"log"
 
 	"github.com/pkg/errors"
 )
@@ -21,9 +20,8 @@ func (as *apiService) StartUserDataStream() (*Stream, error) {
 	}
 	defer res.Body.Close()
 
-	log.Println(string(textRes))
 	if res.StatusCode != 200 {
-		return nil, as.handleError(textRes)
+		return nil, as.handleError(res.StatusCode, textRes)
 	}
 
 	var s Stream
@@ -47,7 +45,7 @@ func (as *apiService) KeepAliveUserDataStream(s *Stream) error {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return as.handleError(textRes)
+		return as.handleError(res.StatusCode, textRes)
 	}
 	return nil
 }
@@ -66,7 +64,7 @@ func (as *apiService) CloseUserDataStream(s *Stream) error {
 	defer res.Body.Close()
 
 	if res.StatusCode != 200 {
-		return as.handleError(textRes)
+		return as.handleError(res.StatusCode, textRes)
 	}
 	return nil
 }
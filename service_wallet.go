@@ -0,0 +1,397 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// withdrawApplyTimeLayout is the layout of UTC time returned in withdraw history.
+const withdrawApplyTimeLayout = "2006-01-02 15:04:05"
+
+func (as *apiService) Withdraw(wr WithdrawRequest) (*WithdrawResult, error) {
+	params := make(map[string]string)
+	params["coin"] = wr.Asset
+	params["address"] = wr.Address
+	params["amount"] = strconv.FormatFloat(wr.Amount, 'f', -1, 64)
+	params["timestamp"] = strconv.FormatInt(unixMillis(wr.Timestamp), 10)
+	if wr.Network != "" {
+		params["network"] = wr.Network
+	}
+	if wr.AddressTag != "" {
+		params["addressTag"] = wr.AddressTag
+	}
+	if wr.WithdrawOrderID != "" {
+		params["withdrawOrderId"] = wr.WithdrawOrderID
+	}
+	if wr.TransactionFeeFlag {
+		params["transactionFeeFlag"] = "true"
+	}
+	if wr.Name != "" {
+		params["name"] = wr.Name
+	}
+	if wr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(wr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("POST", "sapi/v1/capital/withdraw/apply", params)
+	if err != nil {
+		return nil, err
+	}
+
+	rawResult := struct {
+		ID string `json:"id"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawResult); err != nil {
+		return nil, errors.Wrap(err, "rawResult unmarshal failed")
+	}
+
+	return &WithdrawResult{
+		ID: rawResult.ID,
+	}, nil
+}
+
+func (as *apiService) DepositHistory(hr DepositHistoryRequest) ([]*Deposit, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(hr.Timestamp), 10)
+	if hr.Asset != "" {
+		params["coin"] = hr.Asset
+	}
+	if hr.Status != nil {
+		params["status"] = strconv.Itoa(int(*hr.Status))
+	}
+	if hr.TxID != "" {
+		params["txId"] = hr.TxID
+	}
+	if !hr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(hr.StartTime), 10)
+	}
+	if !hr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(hr.EndTime), 10)
+	}
+	if hr.Offset != 0 {
+		params["offset"] = strconv.Itoa(hr.Offset)
+	}
+	if hr.Limit != 0 {
+		params["limit"] = strconv.Itoa(hr.Limit)
+	}
+	if hr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(hr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/capital/deposit/hisrec", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return depositsFromRaw(textRes)
+}
+
+func depositsFromRaw(textRes []byte) ([]*Deposit, error) {
+	rawDeposits := []struct {
+		ID            string        `json:"id"`
+		Amount        string        `json:"amount"`
+		Coin          string        `json:"coin"`
+		Network       string        `json:"network"`
+		Status        DepositStatus `json:"status"`
+		Address       string        `json:"address"`
+		AddressTag    string        `json:"addressTag"`
+		TxID          string        `json:"txId"`
+		InsertTime    float64       `json:"insertTime"`
+		CompleteTime  float64       `json:"completeTime"`
+		TransferType  int           `json:"transferType"`
+		ConfirmTimes  string        `json:"confirmTimes"`
+		UnlockConfirm int           `json:"unlockConfirm"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawDeposits); err != nil {
+		return nil, errors.Wrap(err, "rawDeposits unmarshal failed")
+	}
+
+	var dc []*Deposit
+	for _, d := range rawDeposits {
+		amount, err := floatFromString(d.Amount)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse Deposit.Amount")
+		}
+		it, err := timeFromUnixTimestampFloat(d.InsertTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse Deposit.InsertTime")
+		}
+		var ct time.Time
+		if d.CompleteTime != 0 {
+			ct, _ = timeFromUnixTimestampFloat(d.CompleteTime)
+		}
+		confirmations, required, err := confirmationsFromString(d.ConfirmTimes)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse Deposit.ConfirmTimes")
+		}
+		dc = append(dc, &Deposit{
+			ID:                    d.ID,
+			Amount:                amount,
+			Asset:                 d.Coin,
+			Network:               d.Network,
+			Status:                d.Status,
+			Address:               d.Address,
+			AddressTag:            d.AddressTag,
+			TxID:                  d.TxID,
+			InsertTime:            it,
+			CompleteTime:          ct,
+			TransferType:          d.TransferType,
+			Confirmations:         confirmations,
+			RequiredConfirmations: required,
+			UnlockConfirm:         d.UnlockConfirm,
+		})
+	}
+	return dc, nil
+}
+
+func (as *apiService) WithdrawHistory(hr WithdrawHistoryRequest) ([]*Withdrawal, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(hr.Timestamp), 10)
+	if hr.Asset != "" {
+		params["coin"] = hr.Asset
+	}
+	if hr.WithdrawOrderID != "" {
+		params["withdrawOrderId"] = hr.WithdrawOrderID
+	}
+	if hr.Status != nil {
+		params["status"] = strconv.Itoa(int(*hr.Status))
+	}
+	if !hr.StartTime.IsZero() {
+		params["startTime"] = strconv.FormatInt(unixMillis(hr.StartTime), 10)
+	}
+	if !hr.EndTime.IsZero() {
+		params["endTime"] = strconv.FormatInt(unixMillis(hr.EndTime), 10)
+	}
+	if hr.Offset != 0 {
+		params["offset"] = strconv.Itoa(hr.Offset)
+	}
+	if hr.Limit != 0 {
+		params["limit"] = strconv.Itoa(hr.Limit)
+	}
+	if hr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(hr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/capital/withdraw/history", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return withdrawalsFromRaw(textRes)
+}
+
+func withdrawalsFromRaw(textRes []byte) ([]*Withdrawal, error) {
+	rawWithdrawals := []struct {
+		ID              string         `json:"id"`
+		Amount          string         `json:"amount"`
+		TransactionFee  string         `json:"transactionFee"`
+		Coin            string         `json:"coin"`
+		Status          WithdrawStatus `json:"status"`
+		Address         string         `json:"address"`
+		AddressTag      string         `json:"addressTag"`
+		TxID            string         `json:"txId"`
+		ApplyTime       string         `json:"applyTime"`
+		CompleteTime    string         `json:"completeTime"`
+		Network         string         `json:"network"`
+		TransferType    int            `json:"transferType"`
+		WithdrawOrderID string         `json:"withdrawOrderId"`
+		Info            string         `json:"info"`
+		ConfirmNo       int            `json:"confirmNo"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawWithdrawals); err != nil {
+		return nil, errors.Wrap(err, "rawWithdrawals unmarshal failed")
+	}
+
+	var wc []*Withdrawal
+	for _, w := range rawWithdrawals {
+		amount, err := floatFromString(w.Amount)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse Withdrawal.Amount")
+		}
+		fee, err := floatFromString(w.TransactionFee)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse Withdrawal.TransactionFee")
+		}
+		at, err := time.Parse(withdrawApplyTimeLayout, w.ApplyTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse Withdrawal.ApplyTime")
+		}
+		var ct time.Time
+		if w.CompleteTime != "" {
+			ct, err = time.Parse(withdrawApplyTimeLayout, w.CompleteTime)
+			if err != nil {
+				return nil, errors.Wrap(err, "cannot parse Withdrawal.CompleteTime")
+			}
+		}
+		wc = append(wc, &Withdrawal{
+			ID:              w.ID,
+			Amount:          amount,
+			TransactionFee:  fee,
+			Address:         w.Address,
+			AddressTag:      w.AddressTag,
+			TxID:            w.TxID,
+			Asset:           w.Coin,
+			Network:         w.Network,
+			ApplyTime:       at,
+			CompleteTime:    ct,
+			Status:          w.Status,
+			TransferType:    w.TransferType,
+			WithdrawOrderID: w.WithdrawOrderID,
+			Info:            w.Info,
+			Confirmations:   w.ConfirmNo,
+		})
+	}
+	return wc, nil
+}
+
+func (as *apiService) CoinConfig(ccr CoinConfigRequest) ([]*CoinConfig, error) {
+	params := make(map[string]string)
+	params["timestamp"] = strconv.FormatInt(unixMillis(ccr.Timestamp), 10)
+	if ccr.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(ccr.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/capital/config/getall", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return coinConfigsFromRaw(textRes)
+}
+
+func coinConfigsFromRaw(textRes []byte) ([]*CoinConfig, error) {
+	rawConfigs := []struct {
+		Coin              string      `json:"coin"`
+		Name              string      `json:"name"`
+		DepositAllEnable  bool        `json:"depositAllEnable"`
+		WithdrawAllEnable bool        `json:"withdrawAllEnable"`
+		Free              json.Number `json:"free"`
+		Locked            json.Number `json:"locked"`
+		Freeze            json.Number `json:"freeze"`
+		Withdrawing       json.Number `json:"withdrawing"`
+		NetworkList       []struct {
+			Network                 string      `json:"network"`
+			Name                    string      `json:"name"`
+			IsDefault               bool        `json:"isDefault"`
+			DepositEnable           bool        `json:"depositEnable"`
+			WithdrawEnable          bool        `json:"withdrawEnable"`
+			WithdrawFee             json.Number `json:"withdrawFee"`
+			WithdrawMin             json.Number `json:"withdrawMin"`
+			WithdrawMax             json.Number `json:"withdrawMax"`
+			WithdrawIntegerMultiple json.Number `json:"withdrawIntegerMultiple"`
+			AddressRegex            string      `json:"addressRegex"`
+			MemoRegex               string      `json:"memoRegex"`
+			MinConfirm              int         `json:"minConfirm"`
+			UnLockConfirm           int         `json:"unLockConfirm"`
+			SameAddress             bool        `json:"sameAddress"`
+			DepositDesc             string      `json:"depositDesc"`
+			WithdrawDesc            string      `json:"withdrawDesc"`
+		} `json:"networkList"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawConfigs); err != nil {
+		return nil, errors.Wrap(err, "rawConfigs unmarshal failed")
+	}
+
+	var ccc []*CoinConfig
+	for _, rc := range rawConfigs {
+		free, _ := rc.Free.Float64()
+		locked, _ := rc.Locked.Float64()
+		freeze, _ := rc.Freeze.Float64()
+		withdrawing, _ := rc.Withdrawing.Float64()
+		cc := &CoinConfig{
+			Asset:             rc.Coin,
+			Name:              rc.Name,
+			DepositAllEnable:  rc.DepositAllEnable,
+			WithdrawAllEnable: rc.WithdrawAllEnable,
+			Free:              free,
+			Locked:            locked,
+			Freeze:            freeze,
+			Withdrawing:       withdrawing,
+		}
+		for _, rn := range rc.NetworkList {
+			fee, _ := rn.WithdrawFee.Float64()
+			min, _ := rn.WithdrawMin.Float64()
+			max, _ := rn.WithdrawMax.Float64()
+			multiple, _ := rn.WithdrawIntegerMultiple.Float64()
+			cc.Networks = append(cc.Networks, &NetworkConfig{
+				Network:                 rn.Network,
+				Name:                    rn.Name,
+				IsDefault:               rn.IsDefault,
+				DepositEnable:           rn.DepositEnable,
+				WithdrawEnable:          rn.WithdrawEnable,
+				WithdrawFee:             fee,
+				WithdrawMin:             min,
+				WithdrawMax:             max,
+				WithdrawIntegerMultiple: multiple,
+				AddressRegex:            rn.AddressRegex,
+				MemoRegex:               rn.MemoRegex,
+				MinConfirm:              rn.MinConfirm,
+				UnlockConfirm:           rn.UnLockConfirm,
+				SameAddress:             rn.SameAddress,
+				DepositDesc:             rn.DepositDesc,
+				WithdrawDesc:            rn.WithdrawDesc,
+			})
+		}
+		ccc = append(ccc, cc)
+	}
+	return ccc, nil
+}
+
+func (as *apiService) DepositAddress(dar DepositAddressRequest) (*DepositAddress, error) {
+	params := make(map[string]string)
+	params["coin"] = dar.Asset
+	params["timestamp"] = strconv.FormatInt(unixMillis(dar.Timestamp), 10)
+	if dar.Network != "" {
+		params["network"] = dar.Network
+	}
+	if dar.RecvWindow != 0 {
+		params["recvWindow"] = strconv.FormatInt(recvWindow(dar.RecvWindow), 10)
+	}
+
+	textRes, err := as.signedRequest("GET", "sapi/v1/capital/deposit/address", params)
+	if err != nil {
+		return nil, err
+	}
+
+	rawAddress := struct {
+		Address string `json:"address"`
+		Coin    string `json:"coin"`
+		Tag     string `json:"tag"`
+		URL     string `json:"url"`
+	}{}
+	if err := json.Unmarshal(textRes, &rawAddress); err != nil {
+		return nil, errors.Wrap(err, "rawAddress unmarshal failed")
+	}
+
+	return &DepositAddress{
+		Asset:   rawAddress.Coin,
+		Address: rawAddress.Address,
+		Tag:     rawAddress.Tag,
+		URL:     rawAddress.URL,
+	}, nil
+}
+
+// confirmationsFromString parses confirmations in "current/required" format.
+func confirmationsFromString(raw string) (int, int, error) {
+	if raw == "" {
+		return 0, 0, nil
+	}
+	parts := strings.Split(raw, "/")
+	if len(parts) != 2 {
+		return 0, 0, errors.New(fmt.Sprintf("unable to parse confirmations: %s", raw))
+	}
+	current, err := intFromString(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	required, err := intFromString(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	return current, required, nil
+}
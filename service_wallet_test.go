@@ -0,0 +1,92 @@
+package binance
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDepositsFromRaw(t *testing.T) {
+	textRes := []byte(`[{"id":"769800519366885376","amount":"0.001","coin":"BNB","network":"BNB",
+		"status":0,"address":"bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23","addressTag":"101764890",
+		"txId":"98A3EA560C6B3336D348B6C83F0F95ECE4F1F5919E94BD006E5BF3BF264FACFC",
+		"insertTime":1661493146000,"transferType":0,"confirmTimes":"1/1","unlockConfirm":0,"walletType":0}]`)
+	dc, err := depositsFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dc) != 1 {
+		t.Fatalf("unexpected number of deposits: %d", len(dc))
+	}
+	d := dc[0]
+	if d.Status != DepositPending || d.Amount != 0.001 || d.Network != "BNB" || d.AddressTag != "101764890" {
+		t.Errorf("unexpected deposit: %#v", d)
+	}
+	if d.Confirmations != 1 || d.RequiredConfirmations != 1 {
+		t.Errorf("unexpected confirmations: %d/%d", d.Confirmations, d.RequiredConfirmations)
+	}
+	if !d.InsertTime.Equal(time.Unix(1661493146, 0)) {
+		t.Errorf("unexpected insert time: %s", d.InsertTime)
+	}
+}
+
+func TestWithdrawalsFromRaw(t *testing.T) {
+	textRes := []byte(`[{"id":"b6ae22b3aa844210a7041aee7589627c","amount":"8.91000000",
+		"transactionFee":"0.004","coin":"USDT","status":6,"address":"0x94df8b352de7f46f64b01d3666bf6e936e44ce60",
+		"txId":"0xb5ef8c13b968a406cc62a93a8bd80f9e9a906ef1b3fcf20a2e48573c17659268",
+		"applyTime":"2019-10-12 11:12:02","network":"ETH","transferType":0,
+		"withdrawOrderId":"WITHDRAWtest123","info":"The address is not valid.","confirmNo":3,
+		"walletType":1,"txKey":"","completeTime":"2023-03-23 16:52:41"}]`)
+	wc, err := withdrawalsFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wc) != 1 {
+		t.Fatalf("unexpected number of withdrawals: %d", len(wc))
+	}
+	w := wc[0]
+	if w.Status != WithdrawCompleted || w.Amount != 8.91 || w.TransactionFee != 0.004 || w.Confirmations != 3 {
+		t.Errorf("unexpected withdrawal: %#v", w)
+	}
+	if !w.ApplyTime.Equal(time.Date(2019, 10, 12, 11, 12, 2, 0, time.UTC)) {
+		t.Errorf("unexpected apply time: %s", w.ApplyTime)
+	}
+	if w.Status.String() != "COMPLETED" {
+		t.Errorf("unexpected status name: %s", w.Status)
+	}
+}
+
+func TestCoinConfigsFromRaw(t *testing.T) {
+	textRes := []byte(`[{"coin":"BNB","depositAllEnable":true,"free":"0.08074558","freeze":"0.00000000",
+		"ipoable":"0.00000000","ipoing":"0.00000000","isLegalMoney":false,"locked":"0.00000000","name":"BNB",
+		"networkList":[{"addressRegex":"^(bnb1)[0-9a-z]{38}$","coin":"BNB","depositDesc":"","depositEnable":true,
+		"isDefault":false,"memoRegex":"^[0-9A-Za-z\\-_]{1,120}$","minConfirm":1,"name":"BEP2","network":"BNB",
+		"resetAddressStatus":false,"specialTips":"","unLockConfirm":0,"withdrawDesc":"","withdrawEnable":true,
+		"withdrawFee":"0.00050000","withdrawIntegerMultiple":"0.00000001","withdrawMax":"9999999999.99999999",
+		"withdrawMin":"0.00500000","sameAddress":true},
+		{"addressRegex":"^(0x)[0-9A-Fa-f]{40}$","coin":"BNB","depositEnable":true,"isDefault":true,
+		"memoRegex":"","minConfirm":15,"name":"BNB Smart Chain (BEP20)","network":"BSC","unLockConfirm":0,
+		"withdrawEnable":true,"withdrawFee":"0.00050000","withdrawIntegerMultiple":"0.00000001",
+		"withdrawMax":"9999999999.99999999","withdrawMin":"0.00500000","sameAddress":false}],
+		"storage":"0.00000000","trading":true,"withdrawAllEnable":true,"withdrawing":"0.00000000"}]`)
+	ccc, err := coinConfigsFromRaw(textRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ccc) != 1 || len(ccc[0].Networks) != 2 {
+		t.Fatalf("unexpected coin configs: %#v", ccc)
+	}
+	cc := ccc[0]
+	if cc.Asset != "BNB" || cc.Free != 0.08074558 || !cc.WithdrawAllEnable {
+		t.Errorf("unexpected coin config: %#v", cc)
+	}
+	nc := cc.FindNetwork("")
+	if nc == nil || nc.Network != "BSC" {
+		t.Fatalf("unexpected default network: %#v", nc)
+	}
+	if nc.WithdrawMin != 0.005 || nc.WithdrawFee != 0.0005 || nc.WithdrawIntegerMultiple != 0.00000001 || nc.MinConfirm != 15 {
+		t.Errorf("unexpected network config: %#v", nc)
+	}
+	if bep2 := cc.FindNetwork("BNB"); bep2 == nil || !bep2.SameAddress {
+		t.Errorf("unexpected BNB network config: %#v", bep2)
+	}
+}
@@ -1,417 +1,374 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
 	"github.com/go-kit/kit/log/level"
 	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
 )
 
+const wsURL = "wss://stream.binance.com:9443/ws/"
+
 func (as *apiService) DepthWebsocket(dwr DepthWebsocketRequest) (chan *DepthEvent, chan struct{}, error) {
-	url := fmt.Sprintf("wss://stream.binance.com:9443/ws/%s@depth", strings.ToLower(dwr.Symbol))
-	c, _, err := websocket.DefaultDialer.Dial(url, nil)
+	stream := fmt.Sprintf("%s@depth", strings.ToLower(dwr.Symbol))
+	url := wsURL + stream
+	c, err := as.dial(stream, url)
 	if err != nil {
-		log.Fatal("dial:", err)
+		return nil, nil, err
 	}
 
 	done := make(chan struct{})
 	dech := make(chan *DepthEvent)
 
-	go func() {
-		defer c.Close()
-		defer close(done)
-		for {
-			select {
-			case <-as.Ctx.Done():
-				level.Info(as.Logger).Log("closing reader")
-				return
-			default:
-				_, message, err := c.ReadMessage()
-				if err != nil {
-					level.Error(as.Logger).Log("wsRead", err)
-					return
-				}
-				rawDepth := struct {
-					Type          string          `json:"e"`
-					Time          float64         `json:"E"`
-					Symbol        string          `json:"s"`
-					UpdateID      int             `json:"u"`
-					BidDepthDelta [][]interface{} `json:"b"`
-					AskDepthDelta [][]interface{} `json:"a"`
-				}{}
-				if err := json.Unmarshal(message, &rawDepth); err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
-					return
-				}
-				t, err := timeFromUnixTimestampFloat(rawDepth.Time)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
-					return
-				}
-				de := &DepthEvent{
-					WSEvent: WSEvent{
-						Type:   rawDepth.Type,
-						Time:   t,
-						Symbol: rawDepth.Symbol,
-					},
-					UpdateID: rawDepth.UpdateID,
-				}
-				for _, b := range rawDepth.BidDepthDelta {
-					p, err := floatFromString(b[0])
-					if err != nil {
-						level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
-						return
-					}
-					q, err := floatFromString(b[1])
-					if err != nil {
-						level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
-						return
-					}
-					de.Bids = append(de.Bids, &Order{
-						Price:    p,
-						Quantity: q,
-					})
-				}
-				dech <- de
+	go as.readWS(as.Ctx, c, done, stream, func(message []byte) error {
+		rawDepth := struct {
+			Type          string          `json:"e"`
+			Time          float64         `json:"E"`
+			Symbol        string          `json:"s"`
+			UpdateID      int             `json:"u"`
+			BidDepthDelta [][]interface{} `json:"b"`
+			AskDepthDelta [][]interface{} `json:"a"`
+		}{}
+		if err := json.Unmarshal(message, &rawDepth); err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
+			return err
+		}
+		t, err := timeFromUnixTimestampFloat(rawDepth.Time)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
+			return err
+		}
+		de := &DepthEvent{
+			WSEvent: WSEvent{
+				Type:   rawDepth.Type,
+				Time:   t,
+				Symbol: rawDepth.Symbol,
+			},
+			UpdateID: rawDepth.UpdateID,
+		}
+		for _, b := range rawDepth.BidDepthDelta {
+			p, err := floatFromString(b[0])
+			if err != nil {
+				level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
+				return err
 			}
+			q, err := floatFromString(b[1])
+			if err != nil {
+				level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
+				return err
+			}
+			de.Bids = append(de.Bids, &Order{
+				Price:    p,
+				Quantity: q,
+			})
 		}
-	}()
+		dech <- de
+		return nil
+	})
 
-	go as.exitHandler(c, done)
+	go as.exitHandler(as.Ctx, c, done)
 	return dech, done, nil
 }
 
 func (as *apiService) KlineWebsocket(kwr KlineWebsocketRequest) (chan *KlineEvent, chan struct{}, error) {
-	url := fmt.Sprintf("wss://stream.binance.com:9443/ws/%s@kline_%s", strings.ToLower(kwr.Symbol), string(kwr.Interval))
-	c, _, err := websocket.DefaultDialer.Dial(url, nil)
+	stream := fmt.Sprintf("%s@kline_%s", strings.ToLower(kwr.Symbol), string(kwr.Interval))
+	url := wsURL + stream
+	c, err := as.dial(stream, url)
 	if err != nil {
-		log.Fatal("dial:", err)
+		return nil, nil, err
 	}
 
 	done := make(chan struct{})
 	kech := make(chan *KlineEvent)
 
-	go func() {
-		defer c.Close()
-		defer close(done)
-		for {
-			select {
-			case <-as.Ctx.Done():
-				level.Info(as.Logger).Log("closing reader")
-				return
-			default:
-				_, message, err := c.ReadMessage()
-				if err != nil {
-					level.Error(as.Logger).Log("wsRead", err)
-					return
-				}
-				rawKline := struct {
-					Type   string  `json:"e"`
-					Time   float64 `json:"E"`
-					Symbol string  `json:"s"`
-					Kline  struct {
-						Interval                 string  `json:"i"`
-						FirstTradeID             int64   `json:"f"`
-						LastTradeID              int64   `json:"L"`
-						Final                    bool    `json:"x"`
-						OpenTime                 float64 `json:"t"`
-						CloseTime                float64 `json:"T"`
-						Open                     string  `json:"o"`
-						High                     string  `json:"h"`
-						Low                      string  `json:"l"`
-						Close                    string  `json:"c"`
-						Volume                   string  `json:"v"`
-						NumberOfTrades           int     `json:"n"`
-						QuoteAssetVolume         string  `json:"q"`
-						TakerBuyBaseAssetVolume  string  `json:"V"`
-						TakerBuyQuoteAssetVolume string  `json:"Q"`
-					} `json:"k"`
-					Error struct {
-						Code int64  `json:"code"`
-						Msg  string `json:"msg"`
-					} `json:"error"`
-				}{}
-				if err := json.Unmarshal(message, &rawKline); err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
-					return
-				}
-				if rawKline.Error.Code > 0 {
-					level.Warn(as.Logger).Log("rawKline", rawKline.Error.Code, rawKline.Error.Msg)
-					continue
-				}
-				t, err := timeFromUnixTimestampFloat(rawKline.Time)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Time)
-					return
-				}
-				ot, err := timeFromUnixTimestampFloat(rawKline.Kline.OpenTime)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.OpenTime)
-					return
-				}
-				ct, err := timeFromUnixTimestampFloat(rawKline.Kline.CloseTime)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.CloseTime)
-					return
-				}
-				open, err := floatFromString(rawKline.Kline.Open)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.Open)
-					return
-				}
-				cls, err := floatFromString(rawKline.Kline.Close)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.Close)
-					return
-				}
-				high, err := floatFromString(rawKline.Kline.High)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.High)
-					return
-				}
-				low, err := floatFromString(rawKline.Kline.Low)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.Low)
-					return
-				}
-				vol, err := floatFromString(rawKline.Kline.Volume)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.Volume)
-					return
-				}
-				qav, err := floatFromString(rawKline.Kline.QuoteAssetVolume)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", (rawKline.Kline.QuoteAssetVolume))
-					return
-				}
-				tbbav, err := floatFromString(rawKline.Kline.TakerBuyBaseAssetVolume)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.TakerBuyBaseAssetVolume)
-					return
-				}
-				tbqav, err := floatFromString(rawKline.Kline.TakerBuyQuoteAssetVolume)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.TakerBuyQuoteAssetVolume)
-					return
-				}
+	go as.readWS(as.Ctx, c, done, stream, func(message []byte) error {
+		rawKline := struct {
+			Type   string  `json:"e"`
+			Time   float64 `json:"E"`
+			Symbol string  `json:"s"`
+			Kline  struct {
+				Interval                 string  `json:"i"`
+				FirstTradeID             int64   `json:"f"`
+				LastTradeID              int64   `json:"L"`
+				Final                    bool    `json:"x"`
+				OpenTime                 float64 `json:"t"`
+				CloseTime                float64 `json:"T"`
+				Open                     string  `json:"o"`
+				High                     string  `json:"h"`
+				Low                      string  `json:"l"`
+				Close                    string  `json:"c"`
+				Volume                   string  `json:"v"`
+				NumberOfTrades           int     `json:"n"`
+				QuoteAssetVolume         string  `json:"q"`
+				TakerBuyBaseAssetVolume  string  `json:"V"`
+				TakerBuyQuoteAssetVolume string  `json:"Q"`
+			} `json:"k"`
+			Error struct {
+				Code int64  `json:"code"`
+				Msg  string `json:"msg"`
+			} `json:"error"`
+		}{}
+		if err := json.Unmarshal(message, &rawKline); err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
+			return err
+		}
+		if rawKline.Error.Code > 0 {
+			level.Warn(as.Logger).Log("rawKline", rawKline.Error.Code, rawKline.Error.Msg)
+			return nil
+		}
+		t, err := timeFromUnixTimestampFloat(rawKline.Time)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Time)
+			return err
+		}
+		ot, err := timeFromUnixTimestampFloat(rawKline.Kline.OpenTime)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.OpenTime)
+			return err
+		}
+		ct, err := timeFromUnixTimestampFloat(rawKline.Kline.CloseTime)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.CloseTime)
+			return err
+		}
+		open, err := floatFromString(rawKline.Kline.Open)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.Open)
+			return err
+		}
+		cls, err := floatFromString(rawKline.Kline.Close)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.Close)
+			return err
+		}
+		high, err := floatFromString(rawKline.Kline.High)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.High)
+			return err
+		}
+		low, err := floatFromString(rawKline.Kline.Low)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.Low)
+			return err
+		}
+		vol, err := floatFromString(rawKline.Kline.Volume)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.Volume)
+			return err
+		}
+		qav, err := floatFromString(rawKline.Kline.QuoteAssetVolume)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", (rawKline.Kline.QuoteAssetVolume))
+			return err
+		}
+		tbbav, err := floatFromString(rawKline.Kline.TakerBuyBaseAssetVolume)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.TakerBuyBaseAssetVolume)
+			return err
+		}
+		tbqav, err := floatFromString(rawKline.Kline.TakerBuyQuoteAssetVolume)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawKline.Kline.TakerBuyQuoteAssetVolume)
+			return err
+		}
 
-				ke := &KlineEvent{
-					WSEvent: WSEvent{
-						Type:   rawKline.Type,
-						Time:   t,
-						Symbol: rawKline.Symbol,
-					},
-					Interval:     Interval(rawKline.Kline.Interval),
-					FirstTradeID: rawKline.Kline.FirstTradeID,
-					LastTradeID:  rawKline.Kline.LastTradeID,
-					Final:        rawKline.Kline.Final,
-					Kline: Kline{
-						OpenTime:                 ot,
-						CloseTime:                ct,
-						Open:                     open,
-						Close:                    cls,
-						High:                     high,
-						Low:                      low,
-						Volume:                   vol,
-						NumberOfTrades:           rawKline.Kline.NumberOfTrades,
-						QuoteAssetVolume:         qav,
-						TakerBuyBaseAssetVolume:  tbbav,
-						TakerBuyQuoteAssetVolume: tbqav,
-					},
-				}
-				kech <- ke
-			}
+		ke := &KlineEvent{
+			WSEvent: WSEvent{
+				Type:   rawKline.Type,
+				Time:   t,
+				Symbol: rawKline.Symbol,
+			},
+			Interval:     Interval(rawKline.Kline.Interval),
+			FirstTradeID: rawKline.Kline.FirstTradeID,
+			LastTradeID:  rawKline.Kline.LastTradeID,
+			Final:        rawKline.Kline.Final,
+			Kline: Kline{
+				OpenTime:                 ot,
+				CloseTime:                ct,
+				Open:                     open,
+				Close:                    cls,
+				High:                     high,
+				Low:                      low,
+				Volume:                   vol,
+				NumberOfTrades:           rawKline.Kline.NumberOfTrades,
+				QuoteAssetVolume:         qav,
+				TakerBuyBaseAssetVolume:  tbbav,
+				TakerBuyQuoteAssetVolume: tbqav,
+			},
 		}
-	}()
+		kech <- ke
+		return nil
+	})
 
-	go as.exitHandler(c, done)
+	go as.exitHandler(as.Ctx, c, done)
 	return kech, done, nil
 }
 
 func (as *apiService) TradeWebsocket(twr TradeWebsocketRequest) (chan *AggTradeEvent, chan struct{}, error) {
-	url := fmt.Sprintf("wss://stream.binance.com:9443/ws/%s@aggTrade", strings.ToLower(twr.Symbol))
-	c, _, err := websocket.DefaultDialer.Dial(url, nil)
+	stream := fmt.Sprintf("%s@aggTrade", strings.ToLower(twr.Symbol))
+	url := wsURL + stream
+	c, err := as.dial(stream, url)
 	if err != nil {
-		log.Fatal("dial:", err)
+		return nil, nil, err
 	}
 
+	ctx := twr.Ctx
+	if ctx == nil {
+		ctx = as.Ctx
+	}
 	done := make(chan struct{})
 	aggtech := make(chan *AggTradeEvent)
 
-	go func() {
-		defer c.Close()
-		defer close(done)
-		for {
-			select {
-			case <-as.Ctx.Done():
-				level.Info(as.Logger).Log("closing reader")
-				return
-			default:
-				_, message, err := c.ReadMessage()
-				if err != nil {
-					level.Error(as.Logger).Log("wsRead", err)
-					return
-				}
-				rawAggTrade := struct {
-					Type         string  `json:"e"`
-					Time         float64 `json:"E"`
-					Symbol       string  `json:"s"`
-					TradeID      int     `json:"a"`
-					Price        string  `json:"p"`
-					Quantity     string  `json:"q"`
-					FirstTradeID int     `json:"f"`
-					LastTradeID  int     `json:"l"`
-					Timestamp    float64 `json:"T"`
-					IsMaker      bool    `json:"m"`
-				}{}
-				if err := json.Unmarshal(message, &rawAggTrade); err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
-					return
-				}
-				t, err := timeFromUnixTimestampFloat(rawAggTrade.Time)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawAggTrade.Time)
-					return
-				}
+	go as.readWS(ctx, c, done, stream, func(message []byte) error {
+		rawAggTrade := struct {
+			Type         string  `json:"e"`
+			Time         float64 `json:"E"`
+			Symbol       string  `json:"s"`
+			TradeID      int     `json:"a"`
+			Price        string  `json:"p"`
+			Quantity     string  `json:"q"`
+			FirstTradeID int     `json:"f"`
+			LastTradeID  int     `json:"l"`
+			Timestamp    float64 `json:"T"`
+			IsMaker      bool    `json:"m"`
+		}{}
+		if err := json.Unmarshal(message, &rawAggTrade); err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
+			return err
+		}
+		t, err := timeFromUnixTimestampFloat(rawAggTrade.Time)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawAggTrade.Time)
+			return err
+		}
 
-				price, err := floatFromString(rawAggTrade.Price)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawAggTrade.Price)
-					return
-				}
-				qty, err := floatFromString(rawAggTrade.Quantity)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawAggTrade.Quantity)
-					return
-				}
-				ts, err := timeFromUnixTimestampFloat(rawAggTrade.Timestamp)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawAggTrade.Timestamp)
-					return
-				}
+		price, err := floatFromString(rawAggTrade.Price)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawAggTrade.Price)
+			return err
+		}
+		qty, err := floatFromString(rawAggTrade.Quantity)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawAggTrade.Quantity)
+			return err
+		}
+		ts, err := timeFromUnixTimestampFloat(rawAggTrade.Timestamp)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawAggTrade.Timestamp)
+			return err
+		}
 
-				ae := &AggTradeEvent{
-					WSEvent: WSEvent{
-						Type:   rawAggTrade.Type,
-						Time:   t,
-						Symbol: rawAggTrade.Symbol,
-					},
-					AggTrade: AggTrade{
-						ID:           rawAggTrade.TradeID,
-						Price:        price,
-						Quantity:     qty,
-						FirstTradeID: rawAggTrade.FirstTradeID,
-						LastTradeID:  rawAggTrade.LastTradeID,
-						Timestamp:    ts,
-						BuyerMaker:   rawAggTrade.IsMaker,
-					},
-				}
-				aggtech <- ae
-			}
+		ae := &AggTradeEvent{
+			WSEvent: WSEvent{
+				Type:   rawAggTrade.Type,
+				Time:   t,
+				Symbol: rawAggTrade.Symbol,
+			},
+			AggTrade: AggTrade{
+				ID:           rawAggTrade.TradeID,
+				Price:        price,
+				Quantity:     qty,
+				FirstTradeID: rawAggTrade.FirstTradeID,
+				LastTradeID:  rawAggTrade.LastTradeID,
+				Timestamp:    ts,
+				BuyerMaker:   rawAggTrade.IsMaker,
+			},
+		}
+		select {
+		case aggtech <- ae:
+		case <-ctx.Done():
 		}
-	}()
+		return nil
+	})
 
-	go as.exitHandler(c, done)
+	go as.exitHandler(ctx, c, done)
 	return aggtech, done, nil
 }
 
 func (as *apiService) UserDataWebsocket(urwr UserDataWebsocketRequest) (chan *AccountEvent, chan struct{}, error) {
-	url := fmt.Sprintf("wss://stream.binance.com:9443/ws/%s", urwr.ListenKey)
-	c, _, err := websocket.DefaultDialer.Dial(url, nil)
+	stream := "userData"
+	url := wsURL + urwr.ListenKey
+	c, err := as.dial(stream, url)
 	if err != nil {
-		log.Fatal("dial:", err)
+		return nil, nil, err
 	}
 
 	done := make(chan struct{})
 	aech := make(chan *AccountEvent)
 
-	go func() {
-		defer c.Close()
-		defer close(done)
-		for {
-			select {
-			case <-as.Ctx.Done():
-				level.Info(as.Logger).Log("closing reader")
-				return
-			default:
-				_, message, err := c.ReadMessage()
-				if err != nil {
-					level.Error(as.Logger).Log("wsRead", err)
-					return
-				}
-				rawAccount := struct {
-					Type            string  `json:"e"`
-					Time            float64 `json:"E"`
-					OpenTime        float64 `json:"t"`
-					MakerCommision  int64   `json:"m"`
-					TakerCommision  int64   `json:"t"`
-					BuyerCommision  int64   `json:"b"`
-					SellerCommision int64   `json:"s"`
-					CanTrade        bool    `json:"T"`
-					CanWithdraw     bool    `json:"W"`
-					CanDeposit      bool    `json:"D"`
-					Balances        []struct {
-						Asset            string `json:"a"`
-						AvailableBalance string `json:"f"`
-						Locked           string `json:"l"`
-					} `json:"B"`
-				}{}
-				if err := json.Unmarshal(message, &rawAccount); err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
-					return
-				}
-				t, err := timeFromUnixTimestampFloat(rawAccount.Time)
-				if err != nil {
-					level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawAccount.Time)
-					return
-				}
+	go as.readWS(as.Ctx, c, done, stream, func(message []byte) error {
+		rawAccount := struct {
+			Type            string  `json:"e"`
+			Time            float64 `json:"E"`
+			MakerCommision  int64   `json:"m"`
+			TakerCommision  int64   `json:"t"`
+			BuyerCommision  int64   `json:"b"`
+			SellerCommision int64   `json:"s"`
+			CanTrade        bool    `json:"T"`
+			CanWithdraw     bool    `json:"W"`
+			CanDeposit      bool    `json:"D"`
+			Balances        []struct {
+				Asset            string `json:"a"`
+				AvailableBalance string `json:"f"`
+				Locked           string `json:"l"`
+			} `json:"B"`
+		}{}
+		if err := json.Unmarshal(message, &rawAccount); err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", string(message))
+			return err
+		}
+		t, err := timeFromUnixTimestampFloat(rawAccount.Time)
+		if err != nil {
+			level.Error(as.Logger).Log("wsUnmarshal", err, "body", rawAccount.Time)
+			return err
+		}
 
-				ae := &AccountEvent{
-					WSEvent: WSEvent{
-						Type: rawAccount.Type,
-						Time: t,
-					},
-					Account: Account{
-						MakerCommision:  rawAccount.MakerCommision,
-						TakerCommision:  rawAccount.TakerCommision,
-						BuyerCommision:  rawAccount.BuyerCommision,
-						SellerCommision: rawAccount.SellerCommision,
-						CanTrade:        rawAccount.CanTrade,
-						CanWithdraw:     rawAccount.CanWithdraw,
-						CanDeposit:      rawAccount.CanDeposit,
-					},
-				}
-				for _, b := range rawAccount.Balances {
-					free, err := floatFromString(b.AvailableBalance)
-					if err != nil {
-						level.Error(as.Logger).Log("wsUnmarshal", err, "body", b.AvailableBalance)
-						return
-					}
-					locked, err := floatFromString(b.Locked)
-					if err != nil {
-						level.Error(as.Logger).Log("wsUnmarshal", err, "body", b.Locked)
-						return
-					}
-					ae.Balances = append(ae.Balances, &Balance{
-						Asset:  b.Asset,
-						Free:   free,
-						Locked: locked,
-					})
-				}
-				aech <- ae
+		ae := &AccountEvent{
+			WSEvent: WSEvent{
+				Type: rawAccount.Type,
+				Time: t,
+			},
+			Account: Account{
+				MakerCommision:  rawAccount.MakerCommision,
+				TakerCommision:  rawAccount.TakerCommision,
+				BuyerCommision:  rawAccount.BuyerCommision,
+				SellerCommision: rawAccount.SellerCommision,
+				CanTrade:        rawAccount.CanTrade,
+				CanWithdraw:     rawAccount.CanWithdraw,
+				CanDeposit:      rawAccount.CanDeposit,
+			},
+		}
+		for _, b := range rawAccount.Balances {
+			free, err := floatFromString(b.AvailableBalance)
+			if err != nil {
+				level.Error(as.Logger).Log("wsUnmarshal", err, "body", b.AvailableBalance)
+				return err
+			}
+			locked, err := floatFromString(b.Locked)
+			if err != nil {
+				level.Error(as.Logger).Log("wsUnmarshal", err, "body", b.Locked)
+				return err
 			}
+			ae.Balances = append(ae.Balances, &Balance{
+				Asset:  b.Asset,
+				Free:   free,
+				Locked: locked,
+			})
 		}
-	}()
+		aech <- ae
+		return nil
+	})
 
-	go as.exitHandler(c, done)
+	go as.exitHandler(as.Ctx, c, done)
 	return aech, done, nil
 }
 
-func (as *apiService) exitHandler(c *websocket.Conn, done chan struct{}) {
+func (as *apiService) exitHandler(ctx context.Context, c *websocket.Conn, done chan struct{}) {
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 	defer c.Close()
@@ -424,7 +381,7 @@ func (as *apiService) exitHandler(c *websocket.Conn, done chan struct{}) {
 				level.Error(as.Logger).Log("wsWrite", err)
 				return
 			}
-		case <-as.Ctx.Done():
+		case <-ctx.Done():
 			select {
 			case <-done:
 			case <-time.After(time.Second):
@@ -434,3 +391,47 @@ func (as *apiService) exitHandler(c *websocket.Conn, done chan struct{}) {
 		}
 	}
 }
+
+// dial connects to stream, URL is logged with listen key redacted.
+func (as *apiService) dial(stream, url string) (*websocket.Conn, error) {
+	c, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		level.Error(as.Logger).Log("wsDial", redactURL(url), "err", err)
+		return nil, errors.Wrap(err, "websocket dial failed")
+	}
+	notifyWSState(as.Middleware, stream, true)
+	return c, nil
+}
+
+// readWS reads messages of stream until ctx is done or reading fails.
+// Messages are passed through middleware to dispatch.
+func (as *apiService) readWS(ctx context.Context, c *websocket.Conn, done chan struct{}, stream string,
+	dispatch func(message []byte) error) {
+	defer notifyWSState(as.Middleware, stream, false)
+	defer c.Close()
+	defer close(done)
+	handle := chainWS(func(msg *WSMessage) error {
+		return dispatch(msg.Data)
+	}, as.Middleware)
+	for {
+		select {
+		case <-ctx.Done():
+			level.Info(as.Logger).Log("closing reader")
+			return
+		default:
+			_, message, err := c.ReadMessage()
+			if err != nil {
+				level.Error(as.Logger).Log("wsRead", err)
+				return
+			}
+			msg := &WSMessage{
+				Stream:   stream,
+				Data:     message,
+				Received: time.Now(),
+			}
+			if err := handle(msg); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -1,11 +1,21 @@
 package binance
 
 import (
+	"crypto"
+	"crypto/ed25519"
 	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
+
+	"github.com/pkg/errors"
 )
 
+// minRSAKeyBits is the smallest RSA key accepted by NewRSASigner.
+const minRSAKeyBits = 2048
+
 // Signer signs provided payloads.
 type Signer interface {
 	// Sign signs provided payload and returns encoded string sum.
@@ -23,3 +33,73 @@ func (hs *HmacSigner) Sign(payload []byte) string {
 	mac.Write(payload)
 	return hex.EncodeToString(mac.Sum(nil))
 }
+
+// RSASigner uses RSASSA-PKCS1-v1_5 with SHA256 for signing payloads.
+type RSASigner struct {
+	Key *rsa.PrivateKey
+}
+
+// NewRSASigner returns RSASigner with RSA private key loaded from PEM data.
+// Password is used to decrypt encrypted PKCS#8 key and ignored otherwise.
+func NewRSASigner(pemData, password []byte) (*RSASigner, error) {
+	key, err := parsePrivateKeyPEM(pemData, password)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.Errorf("expected RSA private key, got %T", key)
+	}
+	if rsaKey.N.BitLen() < minRSAKeyBits {
+		return nil, errors.Errorf("RSA key of %d bits is too short", rsaKey.N.BitLen())
+	}
+	if err := rsaKey.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid RSA key")
+	}
+	rsaKey.Precompute()
+	// sign once, so that key which cannot sign is rejected here and not on
+	// request
+	sum := sha256.Sum256(nil)
+	if _, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, sum[:]); err != nil {
+		return nil, errors.Wrap(err, "RSA key cannot sign")
+	}
+	return &RSASigner{Key: rsaKey}, nil
+}
+
+// Sign signs provided payload and returns base64 encoded signature. Keys
+// loaded by NewRSASigner are checked to sign, empty signature is returned
+// when Key set by hand cannot sign and the request is not sent.
+func (rs *RSASigner) Sign(payload []byte) string {
+	sum := sha256.Sum256(payload)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, rs.Key, crypto.SHA256, sum[:])
+	if err != nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(signature)
+}
+
+// Ed25519Signer uses Ed25519 for signing payloads. Ed25519 keys are required
+// for WebSocket API session logon.
+type Ed25519Signer struct {
+	Key ed25519.PrivateKey
+}
+
+// NewEd25519Signer returns Ed25519Signer with Ed25519 private key loaded from
+// PEM data. Password is used to decrypt encrypted PKCS#8 key and ignored
+// otherwise.
+func NewEd25519Signer(pemData, password []byte) (*Ed25519Signer, error) {
+	key, err := parsePrivateKeyPEM(pemData, password)
+	if err != nil {
+		return nil, err
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.Errorf("expected Ed25519 private key, got %T", key)
+	}
+	return &Ed25519Signer{Key: edKey}, nil
+}
+
+// Sign signs provided payload and returns base64 encoded signature.
+func (es *Ed25519Signer) Sign(payload []byte) string {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(es.Key, payload))
+}
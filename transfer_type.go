@@ -0,0 +1,81 @@
+package binance
+
+// TransferType represents universal transfer type enum, named after source
+// and destination wallet.
+type TransferType string
+
+// TransferStatus represents transfer status enum.
+type TransferStatus string
+
+// MarginTransferType represents direction of cross margin transfer.
+type MarginTransferType int
+
+// MarginTransferDirection represents direction of margin transfer in history.
+type MarginTransferDirection string
+
+// MarginWallet represents wallet of isolated margin transfer.
+type MarginWallet string
+
+// SubAccountType represents account type of sub-account transfer.
+type SubAccountType string
+
+// SubAccountTransferStatus represents sub-account transfer status enum.
+type SubAccountTransferStatus string
+
+var (
+	TransferMainToUMFuture                 = TransferType("MAIN_UMFUTURE")
+	TransferMainToCMFuture                 = TransferType("MAIN_CMFUTURE")
+	TransferMainToMargin                   = TransferType("MAIN_MARGIN")
+	TransferMainToFunding                  = TransferType("MAIN_FUNDING")
+	TransferMainToOption                   = TransferType("MAIN_OPTION")
+	TransferMainToPortfolioMargin          = TransferType("MAIN_PORTFOLIO_MARGIN")
+	TransferUMFutureToMain                 = TransferType("UMFUTURE_MAIN")
+	TransferUMFutureToMargin               = TransferType("UMFUTURE_MARGIN")
+	TransferUMFutureToFunding              = TransferType("UMFUTURE_FUNDING")
+	TransferUMFutureToOption               = TransferType("UMFUTURE_OPTION")
+	TransferCMFutureToMain                 = TransferType("CMFUTURE_MAIN")
+	TransferCMFutureToMargin               = TransferType("CMFUTURE_MARGIN")
+	TransferCMFutureToFunding              = TransferType("CMFUTURE_FUNDING")
+	TransferMarginToMain                   = TransferType("MARGIN_MAIN")
+	TransferMarginToUMFuture               = TransferType("MARGIN_UMFUTURE")
+	TransferMarginToCMFuture               = TransferType("MARGIN_CMFUTURE")
+	TransferMarginToIsolatedMargin         = TransferType("MARGIN_ISOLATEDMARGIN")
+	TransferMarginToFunding                = TransferType("MARGIN_FUNDING")
+	TransferMarginToOption                 = TransferType("MARGIN_OPTION")
+	TransferIsolatedMarginToMargin         = TransferType("ISOLATEDMARGIN_MARGIN")
+	TransferIsolatedMarginToIsolatedMargin = TransferType("ISOLATEDMARGIN_ISOLATEDMARGIN")
+	TransferFundingToMain                  = TransferType("FUNDING_MAIN")
+	TransferFundingToUMFuture              = TransferType("FUNDING_UMFUTURE")
+	TransferFundingToCMFuture              = TransferType("FUNDING_CMFUTURE")
+	TransferFundingToMargin                = TransferType("FUNDING_MARGIN")
+	TransferFundingToOption                = TransferType("FUNDING_OPTION")
+	TransferOptionToMain                   = TransferType("OPTION_MAIN")
+	TransferOptionToUMFuture               = TransferType("OPTION_UMFUTURE")
+	TransferOptionToMargin                 = TransferType("OPTION_MARGIN")
+	TransferOptionToFunding                = TransferType("OPTION_FUNDING")
+	TransferPortfolioMarginToMain          = TransferType("PORTFOLIO_MARGIN_MAIN")
+
+	TransferPending   = TransferStatus("PENDING")
+	TransferConfirmed = TransferStatus("CONFIRMED")
+	TransferFailed    = TransferStatus("FAILED")
+
+	MarginTransferToMargin = MarginTransferType(1)
+	MarginTransferToMain   = MarginTransferType(2)
+
+	MarginTransferRollIn  = MarginTransferDirection("ROLL_IN")
+	MarginTransferRollOut = MarginTransferDirection("ROLL_OUT")
+
+	MarginWalletSpot     = MarginWallet("SPOT")
+	MarginWalletCross    = MarginWallet("CROSS_MARGIN")
+	MarginWalletIsolated = MarginWallet("ISOLATED_MARGIN")
+
+	SubAccountSpot           = SubAccountType("SPOT")
+	SubAccountUSDTFuture     = SubAccountType("USDT_FUTURE")
+	SubAccountCoinFuture     = SubAccountType("COIN_FUTURE")
+	SubAccountMargin         = SubAccountType("MARGIN")
+	SubAccountIsolatedMargin = SubAccountType("ISOLATED_MARGIN")
+
+	SubAccountTransferProcessing = SubAccountTransferStatus("PROCESS")
+	SubAccountTransferSuccess    = SubAccountTransferStatus("SUCCESS")
+	SubAccountTransferFailure    = SubAccountTransferStatus("FAILURE")
+)
@@ -54,6 +54,22 @@ func timeFromUnixTimestampFloat(raw interface{}) (time.Time, error) {
 	return time.Unix(0, int64(ts)*int64(time.Millisecond)), nil
 }
 
+// timeFromUnixTimestampNumber parses timestamp sent either as number or as
+// string, zero time is returned when it is missing or zero.
+func timeFromUnixTimestampNumber(raw json.Number) (time.Time, error) {
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	ts, err := raw.Int64()
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, fmt.Sprintf("unable to parse as int: %s", raw))
+	}
+	if ts == 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(0, ts*int64(time.Millisecond)), nil
+}
+
 func unixMillis(t time.Time) int64 {
 	return t.UnixNano() / int64(time.Millisecond)
 }
@@ -62,11 +78,41 @@ func recvWindow(d time.Duration) int64 {
 	return int64(d) / int64(time.Millisecond)
 }
 
-func (as *apiService) handleError(textRes []byte) error {
-	err := &Error{}
+// symbolsParam encodes list of symbols as JSON array expected by API.
+func symbolsParam(symbols []string) string {
+	if symbols == nil {
+		symbols = []string{}
+	}
+	b, _ := json.Marshal(symbols)
+	return string(b)
+}
+
+// windowSizeParam formats d as window size in whole days, hours or minutes.
+// API accepts 1-59m, 1-23h and 1-7d only.
+func windowSizeParam(d time.Duration) (string, error) {
+	switch {
+	case d%(24*time.Hour) == 0 && d >= 24*time.Hour && d <= 7*24*time.Hour:
+		return fmt.Sprintf("%dd", d/(24*time.Hour)), nil
+	case d%time.Hour == 0 && d >= time.Hour && d < 24*time.Hour:
+		return fmt.Sprintf("%dh", d/time.Hour), nil
+	case d%time.Minute == 0 && d >= time.Minute && d < time.Hour:
+		return fmt.Sprintf("%dm", d/time.Minute), nil
+	}
+	return "", errors.Errorf("invalid window size %s, 1-59m, 1-23h or 1-7d expected", d)
+}
+
+func (as *apiService) handleError(statusCode int, textRes []byte) error {
+	err := &Error{StatusCode: statusCode}
 	level.Info(as.Logger).Log("errorResponse", textRes)
-	if err := json.Unmarshal(textRes, err); err != nil {
-		return errors.Wrap(err, "error unmarshal failed")
+	if uerr := json.Unmarshal(textRes, err); uerr != nil || err.Code == 0 && err.Message == "" {
+		return &HTTPError{StatusCode: statusCode, Body: truncate(string(textRes), maxErrorBody)}
 	}
 	return err
 }
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
@@ -0,0 +1,66 @@
+package binance
+
+import "strconv"
+
+// DepositStatus represents deposit status enum.
+type DepositStatus int
+
+// WithdrawStatus represents withdraw status enum.
+type WithdrawStatus int
+
+var (
+	DepositPending          = DepositStatus(0)
+	DepositSuccess          = DepositStatus(1)
+	DepositRejected         = DepositStatus(2)
+	DepositCreditedLocked   = DepositStatus(6)
+	DepositWrong            = DepositStatus(7)
+	DepositWaitingUserInput = DepositStatus(8)
+
+	WithdrawEmailSent        = WithdrawStatus(0)
+	WithdrawCancelled        = WithdrawStatus(1)
+	WithdrawAwaitingApproval = WithdrawStatus(2)
+	WithdrawRejected         = WithdrawStatus(3)
+	WithdrawProcessing       = WithdrawStatus(4)
+	WithdrawFailure          = WithdrawStatus(5)
+	WithdrawCompleted        = WithdrawStatus(6)
+)
+
+// String returns status name.
+func (s DepositStatus) String() string {
+	switch s {
+	case DepositPending:
+		return "PENDING"
+	case DepositSuccess:
+		return "SUCCESS"
+	case DepositRejected:
+		return "REJECTED"
+	case DepositCreditedLocked:
+		return "CREDITED_CANNOT_WITHDRAW"
+	case DepositWrong:
+		return "WRONG_DEPOSIT"
+	case DepositWaitingUserInput:
+		return "WAITING_USER_CONFIRM"
+	}
+	return strconv.Itoa(int(s))
+}
+
+// String returns status name.
+func (s WithdrawStatus) String() string {
+	switch s {
+	case WithdrawEmailSent:
+		return "EMAIL_SENT"
+	case WithdrawCancelled:
+		return "CANCELLED"
+	case WithdrawAwaitingApproval:
+		return "AWAITING_APPROVAL"
+	case WithdrawRejected:
+		return "REJECTED"
+	case WithdrawProcessing:
+		return "PROCESSING"
+	case WithdrawFailure:
+		return "FAILURE"
+	case WithdrawCompleted:
+		return "COMPLETED"
+	}
+	return strconv.Itoa(int(s))
+}
@@ -0,0 +1,422 @@
+package binance
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultApprovalTTL = 5 * time.Minute
+	limitDayLayout     = "2006-01-02"
+	// limitUnit is the finest precision of withdrawn amounts.
+	limitUnit = 1e-8
+)
+
+var (
+	// ErrWithdrawNotAllowed is returned when destination is not on allow-list.
+	ErrWithdrawNotAllowed = errors.New("withdraw destination not allowed")
+	// ErrWithdrawLimitExceeded is returned when withdrawal exceeds daily limit.
+	ErrWithdrawLimitExceeded = errors.New("withdraw daily limit exceeded")
+	// ErrApprovalRequired is returned by Withdraw when guard requires approval.
+	ErrApprovalRequired = errors.New("withdraw approval required")
+	// ErrInvalidApproval is returned for unknown, used or expired approval tokens.
+	ErrInvalidApproval = errors.New("invalid or expired withdraw approval")
+)
+
+// AllowedDestination is withdraw destination allowed by WithdrawGuard.
+//
+// Network and AddressTag must match request exactly, empty Network matches
+// only requests using default network.
+type AllowedDestination struct {
+	Asset      string
+	Network    string
+	Address    string
+	AddressTag string
+}
+
+// WithdrawLimitStore keeps amounts withdrawn per asset and UTC day, so that
+// daily limits survive restarts.
+type WithdrawLimitStore interface {
+	// Withdrawn returns amount of asset withdrawn on day.
+	Withdrawn(asset string, day time.Time) (float64, error)
+	// AddWithdrawn adds amount to asset withdrawn on day. Amount is negative
+	// when reservation of rejected withdrawal is released.
+	AddWithdrawn(asset string, day time.Time, amount float64) error
+}
+
+// WithdrawGuard is an opt-in safety layer around Binance.Withdraw.
+//
+// Every request is checked against allow-list of destinations and daily
+// limits per asset. Assets not present in DailyLimits are not limited. When
+// RequireApproval is set, withdrawals are executed in two steps: Approve
+// checks request and returns token, Execute sends approved request unless the
+// token expired. Every attempt is written to Audit logger, approvals are
+// logged by their approval ID, never by the token itself.
+type WithdrawGuard struct {
+	Binance     Binance
+	Allowed     []AllowedDestination
+	DailyLimits map[string]float64
+	Store       WithdrawLimitStore
+	Audit       log.Logger
+	// RequireApproval disables Withdraw in favour of Approve and Execute.
+	RequireApproval bool
+	// ApprovalTTL is validity of approval token, 5m by default.
+	ApprovalTTL time.Duration
+	// Now returns current time, time.Now by default.
+	Now func() time.Time
+
+	mu        sync.Mutex
+	approvals map[string]*withdrawApproval
+}
+
+type withdrawApproval struct {
+	request WithdrawRequest
+	expires time.Time
+}
+
+// NewWithdrawGuard returns WithdrawGuard allowing withdrawals to allowed
+// destinations. If store or audit are not provided, MemoryWithdrawLimitStore
+// and NopLogger are used as default.
+func NewWithdrawGuard(b Binance, allowed []AllowedDestination, limits map[string]float64,
+	store WithdrawLimitStore, audit log.Logger) *WithdrawGuard {
+	if store == nil {
+		store = NewMemoryWithdrawLimitStore()
+	}
+	if audit == nil {
+		audit = log.NewNopLogger()
+	}
+	return &WithdrawGuard{
+		Binance:     b,
+		Allowed:     allowed,
+		DailyLimits: limits,
+		Store:       store,
+		Audit:       audit,
+		ApprovalTTL: defaultApprovalTTL,
+		Now:         time.Now,
+	}
+}
+
+// Withdraw checks request and sends it to exchange.
+func (wg *WithdrawGuard) Withdraw(wr WithdrawRequest) (*WithdrawResult, error) {
+	wg.mu.Lock()
+	defer wg.mu.Unlock()
+
+	if wg.RequireApproval {
+		wg.audit("withdraw", wr, "rejected", ErrApprovalRequired)
+		return nil, ErrApprovalRequired
+	}
+	return wg.execute("withdraw", wr)
+}
+
+// Approve checks request and returns token to be passed to Execute. Limits
+// are checked again on execution.
+func (wg *WithdrawGuard) Approve(wr WithdrawRequest) (string, error) {
+	wg.mu.Lock()
+	defer wg.mu.Unlock()
+
+	if err := wg.check(wr); err != nil {
+		wg.audit("approve", wr, "rejected", err)
+		return "", err
+	}
+	token, err := approvalToken()
+	if err != nil {
+		wg.audit("approve", wr, "failed", err)
+		return "", err
+	}
+	ttl := wg.ApprovalTTL
+	if ttl <= 0 {
+		ttl = defaultApprovalTTL
+	}
+	if wg.approvals == nil {
+		wg.approvals = make(map[string]*withdrawApproval)
+	}
+	now := wg.now()
+	for t, a := range wg.approvals {
+		if now.After(a.expires) {
+			delete(wg.approvals, t)
+		}
+	}
+	wg.approvals[token] = &withdrawApproval{
+		request: wr,
+		expires: now.Add(ttl),
+	}
+	wg.audit("approve", wr, "approved", nil, "approval", approvalID(token))
+	return token, nil
+}
+
+// Execute sends request approved by token. Each token can be used once.
+func (wg *WithdrawGuard) Execute(token string) (*WithdrawResult, error) {
+	wg.mu.Lock()
+	defer wg.mu.Unlock()
+
+	a, ok := wg.approvals[token]
+	if !ok {
+		wg.audit("execute", WithdrawRequest{}, "rejected", ErrInvalidApproval, "approval", approvalID(token))
+		return nil, ErrInvalidApproval
+	}
+	delete(wg.approvals, token)
+	if wg.now().After(a.expires) {
+		wg.audit("execute", a.request, "rejected", ErrInvalidApproval, "approval", approvalID(token))
+		return nil, ErrInvalidApproval
+	}
+	return wg.execute("execute", a.request, "approval", approvalID(token))
+}
+
+// execute checks and sends request, caller must hold wg.mu.
+//
+// Amount is reserved in Store before request is sent, so that limit holds
+// even when outcome is unknown or Store fails afterwards. Reservation is
+// released only when exchange definitely rejected the request.
+func (wg *WithdrawGuard) execute(action string, wr WithdrawRequest, keyvals ...interface{}) (*WithdrawResult, error) {
+	if err := wg.check(wr); err != nil {
+		wg.audit(action, wr, "rejected", err, keyvals...)
+		return nil, err
+	}
+	day := wg.now()
+	if err := wg.Store.AddWithdrawn(wr.Asset, day, wr.Amount); err != nil {
+		err = errors.Wrap(err, "unable to reserve withdrawn amount")
+		wg.audit(action, wr, "rejected", err, keyvals...)
+		return nil, err
+	}
+	wr.Timestamp = day
+	res, err := wg.Binance.Withdraw(wr)
+	if err != nil && !isAmbiguousError(err) {
+		if serr := wg.Store.AddWithdrawn(wr.Asset, day, -wr.Amount); serr != nil {
+			level.Error(wg.Audit).Log("msg", "unable to release reserved amount", "asset", wr.Asset,
+				"amount", wr.Amount, "err", serr)
+		}
+		wg.audit(action, wr, "failed", err, keyvals...)
+		return nil, err
+	}
+	if err != nil {
+		// amount stays reserved, funds might be gone
+		wg.audit(action, wr, "unknown", err, keyvals...)
+		return nil, err
+	}
+	wg.audit(action, wr, "sent", nil, append(keyvals, "id", res.ID)...)
+	return res, nil
+}
+
+func (wg *WithdrawGuard) check(wr WithdrawRequest) error {
+	if wr.Amount <= 0 {
+		return errors.Errorf("invalid amount %v", wr.Amount)
+	}
+	allowed := false
+	for _, ad := range wg.Allowed {
+		if ad.Asset == wr.Asset && ad.Network == wr.Network &&
+			ad.Address == wr.Address && ad.AddressTag == wr.AddressTag {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return ErrWithdrawNotAllowed
+	}
+	limit, ok := wg.DailyLimits[wr.Asset]
+	if !ok {
+		return nil
+	}
+	withdrawn, err := wg.Store.Withdrawn(wr.Asset, wg.now())
+	if err != nil {
+		return errors.Wrap(err, "unable to load withdrawn amount")
+	}
+	if limitUnits(withdrawn)+limitUnits(wr.Amount) > limitUnits(limit) {
+		return ErrWithdrawLimitExceeded
+	}
+	return nil
+}
+
+// limitUnits converts amount to integer units of limitUnit, so that
+// withdrawal reaching limit exactly isn't rejected due to float rounding.
+func limitUnits(amount float64) int64 {
+	return int64(math.Round(amount / limitUnit))
+}
+
+func (wg *WithdrawGuard) audit(action string, wr WithdrawRequest, result string, err error, keyvals ...interface{}) {
+	kv := []interface{}{
+		"msg", "withdraw attempt",
+		"action", action,
+		"result", result,
+		"asset", wr.Asset,
+		"network", wr.Network,
+		"address", wr.Address,
+		"addressTag", wr.AddressTag,
+		"amount", wr.Amount,
+	}
+	kv = append(kv, keyvals...)
+	if err != nil {
+		kv = append(kv, "err", err)
+		level.Warn(wg.Audit).Log(kv...)
+		return
+	}
+	level.Info(wg.Audit).Log(kv...)
+}
+
+func (wg *WithdrawGuard) now() time.Time {
+	if wg.Now == nil {
+		return time.Now()
+	}
+	return wg.Now()
+}
+
+func approvalToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "unable to generate approval token")
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// approvalID identifies approval token in audit log. Token grants execution
+// of withdrawal, so only a prefix of its hash is logged.
+func approvalID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:4])
+}
+
+func limitKey(asset string, day time.Time) string {
+	return day.UTC().Format(limitDayLayout) + "/" + asset
+}
+
+// MemoryWithdrawLimitStore keeps withdrawn amounts in memory. Limits are
+// reset on restart, use FileWithdrawLimitStore to persist them.
+type MemoryWithdrawLimitStore struct {
+	mu        sync.Mutex
+	withdrawn map[string]float64
+}
+
+// NewMemoryWithdrawLimitStore returns empty MemoryWithdrawLimitStore.
+func NewMemoryWithdrawLimitStore() *MemoryWithdrawLimitStore {
+	return &MemoryWithdrawLimitStore{
+		withdrawn: make(map[string]float64),
+	}
+}
+
+// Withdrawn returns amount of asset withdrawn on day.
+func (ms *MemoryWithdrawLimitStore) Withdrawn(asset string, day time.Time) (float64, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	return ms.withdrawn[limitKey(asset, day)], nil
+}
+
+// AddWithdrawn adds amount to asset withdrawn on day.
+func (ms *MemoryWithdrawLimitStore) AddWithdrawn(asset string, day time.Time, amount float64) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.withdrawn[limitKey(asset, day)] += amount
+	return nil
+}
+
+// FileWithdrawLimitStore keeps withdrawn amounts in JSON file. File is
+// replaced atomically and synced to disk on every change and only the current
+// day is kept.
+type FileWithdrawLimitStore struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewFileWithdrawLimitStore returns store backed by file at path. File is
+// created on first withdrawal.
+func NewFileWithdrawLimitStore(path string) *FileWithdrawLimitStore {
+	return &FileWithdrawLimitStore{
+		Path: path,
+	}
+}
+
+// Withdrawn returns amount of asset withdrawn on day.
+func (fs *FileWithdrawLimitStore) Withdrawn(asset string, day time.Time) (float64, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	withdrawn, err := fs.load()
+	if err != nil {
+		return 0, err
+	}
+	return withdrawn[limitKey(asset, day)], nil
+}
+
+// AddWithdrawn adds amount to asset withdrawn on day.
+func (fs *FileWithdrawLimitStore) AddWithdrawn(asset string, day time.Time, amount float64) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	withdrawn, err := fs.load()
+	if err != nil {
+		return err
+	}
+	key := limitKey(asset, day)
+	prefix := day.UTC().Format(limitDayLayout) + "/"
+	for k := range withdrawn {
+		if len(k) < len(prefix) || k[:len(prefix)] != prefix {
+			delete(withdrawn, k)
+		}
+	}
+	withdrawn[key] += amount
+
+	data, err := json.Marshal(withdrawn)
+	if err != nil {
+		return errors.Wrap(err, "withdrawn marshal failed")
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(fs.Path), filepath.Base(fs.Path)+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "unable to create withdraw limit file")
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return errors.Wrap(err, "unable to write withdraw limit file")
+	}
+	// file must be on disk before rename, otherwise crash can roll total back
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return errors.Wrap(err, "unable to sync withdraw limit file")
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return errors.Wrap(err, "unable to write withdraw limit file")
+	}
+	if err := os.Rename(tmp.Name(), fs.Path); err != nil {
+		os.Remove(tmp.Name())
+		return errors.Wrap(err, "unable to replace withdraw limit file")
+	}
+	return syncDir(filepath.Dir(fs.Path))
+}
+
+// syncDir persists directory entries, e.g. file renamed within dir.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return errors.Wrap(err, "unable to open withdraw limit directory")
+	}
+	defer d.Close()
+	return errors.Wrap(d.Sync(), "unable to sync withdraw limit directory")
+}
+
+func (fs *FileWithdrawLimitStore) load() (map[string]float64, error) {
+	withdrawn := make(map[string]float64)
+	data, err := ioutil.ReadFile(fs.Path)
+	if os.IsNotExist(err) {
+		return withdrawn, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read withdraw limit file")
+	}
+	if err := json.Unmarshal(data, &withdrawn); err != nil {
+		return nil, errors.Wrap(err, "withdraw limit file unmarshal failed")
+	}
+	return withdrawn, nil
+}
@@ -0,0 +1,200 @@
+package binance_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/binance-exchange/go-binance"
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func testWithdrawRequest(amount float64) binance.WithdrawRequest {
+	return binance.WithdrawRequest{
+		Asset:   "BTC",
+		Network: "BTC",
+		Address: "bc1qxy2kgdygjrsqtzq2n0yrf2493p83kkfjhx0wlh",
+		Amount:  amount,
+	}
+}
+
+func newTestWithdrawGuard(binanceService *ServiceMock, store binance.WithdrawLimitStore, audit *bytes.Buffer) *binance.WithdrawGuard {
+	wr := testWithdrawRequest(0)
+	return binance.NewWithdrawGuard(binance.NewBinance(binanceService),
+		[]binance.AllowedDestination{{Asset: wr.Asset, Network: wr.Network, Address: wr.Address}},
+		map[string]float64{"BTC": 1}, store, log.NewLogfmtLogger(audit))
+}
+
+func TestWithdrawGuard(t *testing.T) {
+	binanceService := &ServiceMock{}
+	var audit bytes.Buffer
+	wg := newTestWithdrawGuard(binanceService, nil, &audit)
+
+	binanceService.On("Withdraw", mock.AnythingOfType("binance.WithdrawRequest")).
+		Return(&binance.WithdrawResult{ID: "w1"}, nil).Once()
+	res, err := wg.Withdraw(testWithdrawRequest(0.6))
+	assert.Nil(t, err)
+	assert.Equal(t, "w1", res.ID)
+
+	_, err = wg.Withdraw(testWithdrawRequest(0.5))
+	assert.Equal(t, binance.ErrWithdrawLimitExceeded, err)
+
+	wr := testWithdrawRequest(0.1)
+	wr.Address = "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq"
+	_, err = wg.Withdraw(wr)
+	assert.Equal(t, binance.ErrWithdrawNotAllowed, err)
+
+	binanceService.AssertExpectations(t)
+	assert.Equal(t, 3, bytes.Count(audit.Bytes(), []byte("withdraw attempt")))
+	assert.Contains(t, audit.String(), "result=sent")
+	assert.Contains(t, audit.String(), "id=w1")
+}
+
+func TestWithdrawGuardApproval(t *testing.T) {
+	binanceService := &ServiceMock{}
+	var audit bytes.Buffer
+	wg := newTestWithdrawGuard(binanceService, nil, &audit)
+	wg.RequireApproval = true
+	now := time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)
+	wg.Now = func() time.Time { return now }
+
+	_, err := wg.Withdraw(testWithdrawRequest(0.1))
+	assert.Equal(t, binance.ErrApprovalRequired, err)
+
+	token, err := wg.Approve(testWithdrawRequest(0.1))
+	assert.Nil(t, err)
+	binanceService.On("Withdraw", mock.AnythingOfType("binance.WithdrawRequest")).
+		Return(&binance.WithdrawResult{ID: "w1"}, nil).Once()
+	_, err = wg.Execute(token)
+	assert.Nil(t, err)
+
+	_, err = wg.Execute(token)
+	assert.Equal(t, binance.ErrInvalidApproval, err)
+
+	token, err = wg.Approve(testWithdrawRequest(0.1))
+	assert.Nil(t, err)
+	now = now.Add(6 * time.Minute)
+	_, err = wg.Execute(token)
+	assert.Equal(t, binance.ErrInvalidApproval, err)
+
+	_, err = wg.Approve(testWithdrawRequest(2))
+	assert.Equal(t, binance.ErrWithdrawLimitExceeded, err)
+
+	binanceService.AssertExpectations(t)
+	assert.NotContains(t, audit.String(), token)
+	sum := sha256.Sum256([]byte(token))
+	assert.Contains(t, audit.String(), "approval="+hex.EncodeToString(sum[:4]))
+}
+
+func TestWithdrawGuardAmount(t *testing.T) {
+	binanceService := &ServiceMock{}
+	store := binance.NewMemoryWithdrawLimitStore()
+	wg := newTestWithdrawGuard(binanceService, store, &bytes.Buffer{})
+	wg.DailyLimits["BTC"] = 0.3
+
+	for _, amount := range []float64{0, -0.5} {
+		_, err := wg.Withdraw(testWithdrawRequest(amount))
+		assert.NotNil(t, err, "amount %v", amount)
+	}
+	binanceService.AssertNotCalled(t, "Withdraw", mock.Anything)
+
+	// 0.1+0.2 > 0.3 in float arithmetic
+	binanceService.On("Withdraw", mock.AnythingOfType("binance.WithdrawRequest")).
+		Return(&binance.WithdrawResult{ID: "w1"}, nil).Twice()
+	_, err := wg.Withdraw(testWithdrawRequest(0.1))
+	assert.Nil(t, err)
+	_, err = wg.Withdraw(testWithdrawRequest(0.2))
+	assert.Nil(t, err)
+	_, err = wg.Withdraw(testWithdrawRequest(0.00000001))
+	assert.Equal(t, binance.ErrWithdrawLimitExceeded, err)
+	binanceService.AssertExpectations(t)
+}
+
+// failingStore fails every AddWithdrawn call.
+type failingStore struct {
+	*binance.MemoryWithdrawLimitStore
+}
+
+func (fs failingStore) AddWithdrawn(asset string, day time.Time, amount float64) error {
+	return errors.New("disk full")
+}
+
+func TestWithdrawGuardStoreFailure(t *testing.T) {
+	binanceService := &ServiceMock{}
+	var audit bytes.Buffer
+	wg := newTestWithdrawGuard(binanceService, failingStore{binance.NewMemoryWithdrawLimitStore()}, &audit)
+
+	_, err := wg.Withdraw(testWithdrawRequest(0.1))
+	assert.NotNil(t, err)
+	binanceService.AssertNotCalled(t, "Withdraw", mock.Anything)
+	assert.Contains(t, audit.String(), "result=rejected")
+}
+
+func TestWithdrawGuardReservation(t *testing.T) {
+	binanceService := &ServiceMock{}
+	store := binance.NewMemoryWithdrawLimitStore()
+	wg := newTestWithdrawGuard(binanceService, store, &bytes.Buffer{})
+
+	binanceService.On("Withdraw", mock.AnythingOfType("binance.WithdrawRequest")).
+		Return(nil, &binance.Error{Code: -4026, Message: "insufficient balance"}).Once()
+	_, err := wg.Withdraw(testWithdrawRequest(0.6))
+	assert.NotNil(t, err)
+	withdrawn, _ := store.Withdrawn("BTC", time.Now())
+	assert.Equal(t, 0.0, withdrawn)
+
+	binanceService.On("Withdraw", mock.AnythingOfType("binance.WithdrawRequest")).
+		Return(nil, &binance.Error{Code: -1007, Message: "timeout"}).Once()
+	_, err = wg.Withdraw(testWithdrawRequest(0.6))
+	assert.NotNil(t, err)
+	withdrawn, _ = store.Withdrawn("BTC", time.Now())
+	assert.Equal(t, 0.6, withdrawn)
+
+	_, err = wg.Withdraw(testWithdrawRequest(0.6))
+	assert.Equal(t, binance.ErrWithdrawLimitExceeded, err)
+	binanceService.AssertExpectations(t)
+}
+
+func TestWithdrawGuardGatewayError(t *testing.T) {
+	binanceService := &ServiceMock{}
+	store := binance.NewMemoryWithdrawLimitStore()
+	wg := newTestWithdrawGuard(binanceService, store, &bytes.Buffer{})
+
+	binanceService.On("Withdraw", mock.AnythingOfType("binance.WithdrawRequest")).
+		Return(nil, &binance.HTTPError{StatusCode: 503, Body: "<html>503 Service Unavailable</html>"}).Once()
+	_, err := wg.Withdraw(testWithdrawRequest(0.6))
+	assert.NotNil(t, err)
+	withdrawn, _ := store.Withdrawn("BTC", time.Now())
+	assert.Equal(t, 0.6, withdrawn)
+	binanceService.AssertExpectations(t)
+}
+
+func TestFileWithdrawLimitStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "withdraw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "limits.json")
+	day := time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	store := binance.NewFileWithdrawLimitStore(path)
+	assert.Nil(t, store.AddWithdrawn("BTC", day, 0.5))
+	assert.Nil(t, store.AddWithdrawn("BTC", day, 0.25))
+
+	restarted := binance.NewFileWithdrawLimitStore(path)
+	withdrawn, err := restarted.Withdrawn("BTC", day)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.75, withdrawn)
+
+	withdrawn, err = restarted.Withdrawn("BTC", day.Add(24*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, withdrawn)
+}
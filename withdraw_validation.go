@@ -0,0 +1,127 @@
+package binance
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ErrPatternUnsupported is cause of Validate error when address or tag
+// pattern of network cannot be compiled by Go regexp. Exchange sends Java
+// patterns, which may use lookaround not supported by RE2.
+var ErrPatternUnsupported = errors.New("unsupported pattern")
+
+// WithdrawValidateOptions represents options of ValidateWithOptions.
+type WithdrawValidateOptions struct {
+	// SkipUnsupportedPatterns skips address and tag checks of patterns
+	// failing with ErrPatternUnsupported, other checks still apply.
+	SkipUnsupportedPatterns bool
+}
+
+// FindNetwork returns config of network, default network is returned when
+// network is empty. Nil is returned when no such network exists.
+func (cc *CoinConfig) FindNetwork(network string) *NetworkConfig {
+	for _, nc := range cc.Networks {
+		if network == "" && nc.IsDefault || network != "" && nc.Network == network {
+			return nc
+		}
+	}
+	return nil
+}
+
+// Validate checks WithdrawRequest against coin configs returned by CoinConfig,
+// so that withdrawal can be rejected before it is sent to exchange.
+//
+// Request is checked against withdraw enabled flags, minimum and maximum
+// amount, amount multiple and address and tag patterns of the network. When
+// network pattern cannot be compiled, returned error has ErrPatternUnsupported
+// as its cause, use ValidateWithOptions to skip such checks.
+func (wr WithdrawRequest) Validate(ccc []*CoinConfig) error {
+	return wr.ValidateWithOptions(ccc, WithdrawValidateOptions{})
+}
+
+// ValidateWithOptions checks WithdrawRequest like Validate with options.
+func (wr WithdrawRequest) ValidateWithOptions(ccc []*CoinConfig, opts WithdrawValidateOptions) error {
+	var cc *CoinConfig
+	for _, c := range ccc {
+		if c.Asset == wr.Asset {
+			cc = c
+			break
+		}
+	}
+	if cc == nil {
+		return errors.Errorf("unknown asset %s", wr.Asset)
+	}
+	if !cc.WithdrawAllEnable {
+		return errors.Errorf("withdrawals of %s are disabled", wr.Asset)
+	}
+	nc := cc.FindNetwork(wr.Network)
+	if nc == nil {
+		return errors.Errorf("unknown network %q of %s", wr.Network, wr.Asset)
+	}
+	if !nc.WithdrawEnable {
+		return errors.Errorf("withdrawals of %s on %s are disabled", wr.Asset, nc.Network)
+	}
+	if wr.Amount <= 0 {
+		return errors.Errorf("invalid amount %v", wr.Amount)
+	}
+	if wr.Amount < nc.WithdrawMin {
+		return errors.Errorf("amount %v below minimum %v", wr.Amount, nc.WithdrawMin)
+	}
+	if nc.WithdrawMax > 0 && wr.Amount > nc.WithdrawMax {
+		return errors.Errorf("amount %v above maximum %v", wr.Amount, nc.WithdrawMax)
+	}
+	if nc.WithdrawIntegerMultiple > 0 {
+		if !isMultiple(wr.Amount, nc.WithdrawIntegerMultiple) {
+			return errors.Errorf("amount %v is not multiple of %v", wr.Amount, nc.WithdrawIntegerMultiple)
+		}
+	}
+	if err := matchPattern("address", nc.AddressRegex, wr.Address, opts); err != nil {
+		return err
+	}
+	if nc.SameAddress && wr.AddressTag == "" {
+		return errors.Errorf("address tag required on %s", nc.Network)
+	}
+	if wr.AddressTag != "" {
+		if err := matchPattern("address tag", nc.MemoRegex, wr.AddressTag, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func matchPattern(name, pattern, value string, opts WithdrawValidateOptions) error {
+	if pattern == "" {
+		return nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		// pattern is provided by exchange and not guaranteed to be RE2, check
+		// is skipped only when caller opted in
+		if opts.SkipUnsupportedPatterns {
+			return nil
+		}
+		return errors.Wrapf(ErrPatternUnsupported, "unable to check %s %q: %v", name, pattern, err)
+	}
+	if !re.MatchString(value) {
+		return errors.Errorf("invalid %s %q", name, value)
+	}
+	return nil
+}
+
+// isMultiple reports whether amount is integer multiple of multiple. Values
+// are compared as exact decimals of their shortest representation, so that
+// float rounding doesn't hide extra digits of large amounts.
+func isMultiple(amount, multiple float64) bool {
+	a, ok := new(big.Rat).SetString(strconv.FormatFloat(amount, 'f', -1, 64))
+	if !ok {
+		return false
+	}
+	m, ok := new(big.Rat).SetString(strconv.FormatFloat(multiple, 'f', -1, 64))
+	if !ok || m.Sign() == 0 {
+		return false
+	}
+	return a.Quo(a, m).IsInt()
+}
@@ -0,0 +1,101 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestWithdrawRequestValidate(t *testing.T) {
+	ccc := []*CoinConfig{{
+		Asset:             "XRP",
+		WithdrawAllEnable: true,
+		Networks: []*NetworkConfig{{
+			Network:                 "XRP",
+			IsDefault:               true,
+			WithdrawEnable:          true,
+			WithdrawMin:             20,
+			WithdrawMax:             1000,
+			WithdrawIntegerMultiple: 0.000001,
+			AddressRegex:            "^r[1-9A-HJ-NP-Za-km-z]{25,34}$",
+			MemoRegex:               "^[1-9][0-9]{0,9}$",
+			SameAddress:             true,
+		}, {
+			Network:     "BSC",
+			WithdrawMin: 0.5,
+		}},
+	}}
+	valid := WithdrawRequest{
+		Asset:      "XRP",
+		Address:    "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh",
+		AddressTag: "104446129",
+		Amount:     25.123456,
+	}
+	if err := valid.Validate(ccc); err != nil {
+		t.Fatalf("valid request rejected: %v", err)
+	}
+
+	for name, modify := range map[string]func(wr *WithdrawRequest){
+		"unknown asset":    func(wr *WithdrawRequest) { wr.Asset = "ETH" },
+		"unknown network":  func(wr *WithdrawRequest) { wr.Network = "ETH" },
+		"disabled network": func(wr *WithdrawRequest) { wr.Network = "BSC" },
+		"below minimum":    func(wr *WithdrawRequest) { wr.Amount = 19.5 },
+		"above maximum":    func(wr *WithdrawRequest) { wr.Amount = 1000.5 },
+		"not multiple":     func(wr *WithdrawRequest) { wr.Amount = 25.1234567 },
+		"invalid address":  func(wr *WithdrawRequest) { wr.Address = "0x94df8b352de7f46f64b01d3666bf6e936e44ce60" },
+		"missing tag":      func(wr *WithdrawRequest) { wr.AddressTag = "" },
+		"invalid tag":      func(wr *WithdrawRequest) { wr.AddressTag = "0104446129" },
+	} {
+		wr := valid
+		modify(&wr)
+		if err := wr.Validate(ccc); err == nil {
+			t.Errorf("%s: request not rejected", name)
+		}
+	}
+
+	// lookahead of Java pattern sent by exchange isn't supported by RE2
+	ccc[0].Networks[0].MemoRegex = "^((?!0)[0-9]{1,10})$"
+	if err := valid.Validate(ccc); errors.Cause(err) != ErrPatternUnsupported {
+		t.Errorf("unexpected error of unsupported tag pattern: %v", err)
+	}
+	skip := WithdrawValidateOptions{SkipUnsupportedPatterns: true}
+	if err := valid.ValidateWithOptions(ccc, skip); err != nil {
+		t.Errorf("valid request rejected when skipping unsupported pattern: %v", err)
+	}
+	invalid := valid
+	invalid.Address = "0x94df8b352de7f46f64b01d3666bf6e936e44ce60"
+	if err := invalid.ValidateWithOptions(ccc, skip); err == nil {
+		t.Error("invalid address not rejected when skipping unsupported pattern")
+	}
+
+	ccc[0].WithdrawAllEnable = false
+	if err := valid.Validate(ccc); err == nil {
+		t.Error("request of disabled asset not rejected")
+	}
+}
+
+func TestIsMultiple(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		multiple float64
+		want     bool
+	}{
+		{25.123456, 0.000001, true},
+		{25.1234567, 0.000001, false},
+		{12345.12345678, 0.00000001, true},
+		{5.000000001, 0.00000001, false},
+		{12345678.12345678, 0.00000001, true},
+		{600000000.5, 0.00000001, true},
+		{600000000.5, 1, false},
+		{1.5, 0.5, true},
+		{1.25, 0.5, false},
+		{0.3, 0.1, true},
+		{100, 10, true},
+		{105, 10, false},
+	}
+	for _, tt := range tests {
+		if got := isMultiple(tt.amount, tt.multiple); got != tt.want {
+			t.Errorf("isMultiple(%v, %v) = %v, want %v", tt.amount, tt.multiple, got, tt.want)
+		}
+	}
+}